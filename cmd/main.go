@@ -4,11 +4,17 @@ import (
 	"book/config"
 	"book/grpc"
 	"book/grpc/client"
+	"book/grpc/interceptor"
+	"book/grpc/service"
 	"book/pkg/logger"
+	"book/pkg/metadata"
 	"book/storage/postgres"
 
 	"context"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
 )
 
 func main() {
@@ -38,14 +44,37 @@ func main() {
 	if err != nil {
 		log.Panic("client.NewGrpcClients", logger.Error(err))
 	}
+	defer svcs.Close()
 
-	grpcServer := grpc.SetUpServer(cfg, log, pgStore, svcs)
+	users := interceptor.NewAuthUserStore(svcs, cfg.UserCacheTTL)
+
+	provider, err := metadata.NewFromConfig(cfg)
+	if err != nil {
+		log.Panic("metadata.NewFromConfig", logger.Error(err))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cachedProvider := service.NewCachedProvider(cfg, log, pgStore, provider)
+	go cachedProvider.RunRefresher(ctx)
+
+	grpcServer := grpc.SetUpServer(cfg, log, pgStore, svcs, users, cachedProvider)
 
 	lis, err := net.Listen("tcp", cfg.BookGRPCPort)
 	if err != nil {
 		log.Panic("net.Listen", logger.Error(err))
 	}
 
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+		<-quit
+
+		log.Info("GRPC: Server shutting down...")
+		grpcServer.GracefulStop()
+	}()
+
 	log.Info("GRPC: Server being started...", logger.String("port", cfg.BookGRPCPort))
 
 	if err := grpcServer.Serve(lis); err != nil {
@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cast"
@@ -37,12 +39,30 @@ type Config struct {
 	DefaultLimit  string
 
 	SecretKey string
+	SignTTL   time.Duration
 
 	PasscodePool   string
 	PasscodeLength int
 
 	BookServiceHost string
 	BookGRPCPort    string
+
+	MetadataProviders []string
+	MetadataTimeout   time.Duration
+	OpenLibraryURL    string
+	GoogleBooksURL    string
+	GoogleBooksAPIKey string
+
+	MetadataCacheTTL        time.Duration
+	MetadataRefreshInterval time.Duration
+	MetadataRefreshBatch    int32
+
+	AuthServiceHost string
+	AuthGRPCPort    string
+	UserCacheTTL    time.Duration
+
+	GRPCKeepaliveTime    time.Duration
+	GRPCKeepaliveTimeout time.Duration
 }
 
 // Load ...
@@ -72,6 +92,7 @@ func Load() Config {
 	config.DefaultLimit = cast.ToString(getOrReturnDefaultValue("DEFAULT_LIMIT", "10"))
 
 	config.SecretKey = cast.ToString(getOrReturnDefaultValue("SECRET_KEY", "Here$houldBe$ome$ecretKey"))
+	config.SignTTL = cast.ToDuration(getOrReturnDefaultValue("SIGN_TTL", "5m"))
 
 	config.PasscodePool = cast.ToString(getOrReturnDefaultValue("PASSCODE_POOL", "0123456789"))
 	config.PasscodeLength = cast.ToInt(getOrReturnDefaultValue("PASSCODE_LENGTH", "6"))
@@ -79,6 +100,24 @@ func Load() Config {
 	config.BookServiceHost = cast.ToString(getOrReturnDefaultValue("BOOK_SERVICE_HOST", "0.0.0.0"))
 	config.BookGRPCPort = cast.ToString(getOrReturnDefaultValue("BOOK_GRPC_PORT", ":9101"))
 
+	// comma separated, asked in the given order
+	config.MetadataProviders = strings.Split(cast.ToString(getOrReturnDefaultValue("METADATA_PROVIDERS", "openlibrary,googlebooks")), ",")
+	config.MetadataTimeout = cast.ToDuration(getOrReturnDefaultValue("METADATA_TIMEOUT", "10s"))
+	config.OpenLibraryURL = cast.ToString(getOrReturnDefaultValue("OPEN_LIBRARY_URL", "https://openlibrary.org"))
+	config.GoogleBooksURL = cast.ToString(getOrReturnDefaultValue("GOOGLE_BOOKS_URL", "https://www.googleapis.com"))
+	config.GoogleBooksAPIKey = cast.ToString(getOrReturnDefaultValue("GOOGLE_BOOKS_API_KEY", ""))
+
+	config.MetadataCacheTTL = cast.ToDuration(getOrReturnDefaultValue("METADATA_CACHE_TTL", "720h"))
+	config.MetadataRefreshInterval = cast.ToDuration(getOrReturnDefaultValue("METADATA_REFRESH_INTERVAL", "1h"))
+	config.MetadataRefreshBatch = cast.ToInt32(getOrReturnDefaultValue("METADATA_REFRESH_BATCH", 50))
+
+	config.AuthServiceHost = cast.ToString(getOrReturnDefaultValue("AUTH_SERVICE_HOST", "localhost"))
+	config.AuthGRPCPort = cast.ToString(getOrReturnDefaultValue("AUTH_GRPC_PORT", ":9102"))
+	config.UserCacheTTL = cast.ToDuration(getOrReturnDefaultValue("USER_CACHE_TTL", "1m"))
+
+	config.GRPCKeepaliveTime = cast.ToDuration(getOrReturnDefaultValue("GRPC_KEEPALIVE_TIME", "5m"))
+	config.GRPCKeepaliveTimeout = cast.ToDuration(getOrReturnDefaultValue("GRPC_KEEPALIVE_TIMEOUT", "20s"))
+
 	return config
 }
 
@@ -11,4 +11,6 @@ const (
 	ErrObjectType                  = "object type error: code = NodFound"
 	ErrEnvNodFound                 = "No .env file found"
 	DateFormat                     = "2006-01-02"
+	UserIDMetadataKey              = "user_id"
+	ErrUserIDNotFound              = "user id is missing from request metadata"
 )
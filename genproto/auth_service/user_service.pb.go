@@ -24,8 +24,8 @@ var File_user_service_proto protoreflect.FileDescriptor
 var file_user_service_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x1a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xf4,
-	0x02, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43,
+	0x63, 0x65, 0x1a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xbd,
+	0x03, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43,
 	0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
 	0x65, 0x72, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
@@ -48,9 +48,13 @@ var file_user_service_proto_rawDesc = []byte{
 	0x74, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79,
 	0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x2e, 0x4f, 0x6e, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x17, 0x5a, 0x15, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x1d, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x6e, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x17,
+	0x5a, 0x15, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_user_service_proto_goTypes = []interface{}{
@@ -59,10 +63,11 @@ var file_user_service_proto_goTypes = []interface{}{
 	(*UserListRequest)(nil),   // 2: auth_service.UserListRequest
 	(*CheckUserRequest)(nil),  // 3: auth_service.CheckUserRequest
 	(*GetByName)(nil),         // 4: auth_service.GetByName
-	(*OneUserResponse)(nil),   // 5: auth_service.OneUserResponse
-	(*User)(nil),              // 6: auth_service.User
-	(*UserListResponse)(nil),  // 7: auth_service.UserListResponse
-	(*CheckUserResponse)(nil), // 8: auth_service.CheckUserResponse
+	(*GetByKey)(nil),          // 5: auth_service.GetByKey
+	(*OneUserResponse)(nil),   // 6: auth_service.OneUserResponse
+	(*User)(nil),              // 7: auth_service.User
+	(*UserListResponse)(nil),  // 8: auth_service.UserListResponse
+	(*CheckUserResponse)(nil), // 9: auth_service.CheckUserResponse
 }
 var file_user_service_proto_depIdxs = []int32{
 	0, // 0: auth_service.UserService.Create:input_type -> auth_service.CreateUser
@@ -70,13 +75,15 @@ var file_user_service_proto_depIdxs = []int32{
 	2, // 2: auth_service.UserService.GetUserList:input_type -> auth_service.UserListRequest
 	3, // 3: auth_service.UserService.CheckUser:input_type -> auth_service.CheckUserRequest
 	4, // 4: auth_service.UserService.GetUserByName:input_type -> auth_service.GetByName
-	5, // 5: auth_service.UserService.Create:output_type -> auth_service.OneUserResponse
-	6, // 6: auth_service.UserService.GetByID:output_type -> auth_service.User
-	7, // 7: auth_service.UserService.GetUserList:output_type -> auth_service.UserListResponse
-	8, // 8: auth_service.UserService.CheckUser:output_type -> auth_service.CheckUserResponse
-	5, // 9: auth_service.UserService.GetUserByName:output_type -> auth_service.OneUserResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
+	5, // 5: auth_service.UserService.GetUserByKey:input_type -> auth_service.GetByKey
+	6, // 6: auth_service.UserService.Create:output_type -> auth_service.OneUserResponse
+	7, // 7: auth_service.UserService.GetByID:output_type -> auth_service.User
+	8, // 8: auth_service.UserService.GetUserList:output_type -> auth_service.UserListResponse
+	9, // 9: auth_service.UserService.CheckUser:output_type -> auth_service.CheckUserResponse
+	6, // 10: auth_service.UserService.GetUserByName:output_type -> auth_service.OneUserResponse
+	6, // 11: auth_service.UserService.GetUserByKey:output_type -> auth_service.OneUserResponse
+	6, // [6:12] is the sub-list for method output_type
+	0, // [0:6] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -24,6 +24,7 @@ const (
 	UserService_GetUserList_FullMethodName   = "/auth_service.UserService/GetUserList"
 	UserService_CheckUser_FullMethodName     = "/auth_service.UserService/CheckUser"
 	UserService_GetUserByName_FullMethodName = "/auth_service.UserService/GetUserByName"
+	UserService_GetUserByKey_FullMethodName  = "/auth_service.UserService/GetUserByKey"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -35,6 +36,7 @@ type UserServiceClient interface {
 	GetUserList(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserListResponse, error)
 	CheckUser(ctx context.Context, in *CheckUserRequest, opts ...grpc.CallOption) (*CheckUserResponse, error)
 	GetUserByName(ctx context.Context, in *GetByName, opts ...grpc.CallOption) (*OneUserResponse, error)
+	GetUserByKey(ctx context.Context, in *GetByKey, opts ...grpc.CallOption) (*OneUserResponse, error)
 }
 
 type userServiceClient struct {
@@ -90,6 +92,15 @@ func (c *userServiceClient) GetUserByName(ctx context.Context, in *GetByName, op
 	return out, nil
 }
 
+func (c *userServiceClient) GetUserByKey(ctx context.Context, in *GetByKey, opts ...grpc.CallOption) (*OneUserResponse, error) {
+	out := new(OneUserResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserByKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -99,6 +110,7 @@ type UserServiceServer interface {
 	GetUserList(context.Context, *UserListRequest) (*UserListResponse, error)
 	CheckUser(context.Context, *CheckUserRequest) (*CheckUserResponse, error)
 	GetUserByName(context.Context, *GetByName) (*OneUserResponse, error)
+	GetUserByKey(context.Context, *GetByKey) (*OneUserResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -121,6 +133,9 @@ func (UnimplementedUserServiceServer) CheckUser(context.Context, *CheckUserReque
 func (UnimplementedUserServiceServer) GetUserByName(context.Context, *GetByName) (*OneUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserByName not implemented")
 }
+func (UnimplementedUserServiceServer) GetUserByKey(context.Context, *GetByKey) (*OneUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByKey not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -224,6 +239,24 @@ func _UserService_GetUserByName_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUserByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserByKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserByKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserByKey(ctx, req.(*GetByKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,6 +284,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserByName",
 			Handler:    _UserService_GetUserByName_Handler,
 		},
+		{
+			MethodName: "GetUserByKey",
+			Handler:    _UserService_GetUserByKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user_service.proto",
@@ -20,19 +20,190 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BookStatus int32
+
+const (
+	BookStatus_NEW      BookStatus = 0
+	BookStatus_READING  BookStatus = 1
+	BookStatus_FINISHED BookStatus = 2
+)
+
+// Enum value maps for BookStatus.
+var (
+	BookStatus_name = map[int32]string{
+		0: "NEW",
+		1: "READING",
+		2: "FINISHED",
+	}
+	BookStatus_value = map[string]int32{
+		"NEW":      0,
+		"READING":  1,
+		"FINISHED": 2,
+	}
+)
+
+func (x BookStatus) Enum() *BookStatus {
+	p := new(BookStatus)
+	*p = x
+	return p
+}
+
+func (x BookStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_book_proto_enumTypes[0].Descriptor()
+}
+
+func (BookStatus) Type() protoreflect.EnumType {
+	return &file_book_proto_enumTypes[0]
+}
+
+func (x BookStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookStatus.Descriptor instead.
+func (BookStatus) EnumDescriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{0}
+}
+
+// Ownership says where a book on the shelf comes from, independent of its
+// reading status.
+type Ownership int32
+
+const (
+	Ownership_OWNED    Ownership = 0
+	Ownership_BORROWED Ownership = 1 // from a library or a person, see borrowed_from and return_by
+	Ownership_WISHLIST Ownership = 2 // wanted but not on the shelf yet, see priority and where_to_buy
+)
+
+// Enum value maps for Ownership.
+var (
+	Ownership_name = map[int32]string{
+		0: "OWNED",
+		1: "BORROWED",
+		2: "WISHLIST",
+	}
+	Ownership_value = map[string]int32{
+		"OWNED":    0,
+		"BORROWED": 1,
+		"WISHLIST": 2,
+	}
+)
+
+func (x Ownership) Enum() *Ownership {
+	p := new(Ownership)
+	*p = x
+	return p
+}
+
+func (x Ownership) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Ownership) Descriptor() protoreflect.EnumDescriptor {
+	return file_book_proto_enumTypes[1].Descriptor()
+}
+
+func (Ownership) Type() protoreflect.EnumType {
+	return &file_book_proto_enumTypes[1]
+}
+
+func (x Ownership) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Ownership.Descriptor instead.
+func (Ownership) EnumDescriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{1}
+}
+
+type WishlistFilter int32
+
+const (
+	WishlistFilter_WISHLIST_EXCLUDE WishlistFilter = 0
+	WishlistFilter_WISHLIST_INCLUDE WishlistFilter = 1
+	WishlistFilter_WISHLIST_ONLY    WishlistFilter = 2
+)
+
+// Enum value maps for WishlistFilter.
+var (
+	WishlistFilter_name = map[int32]string{
+		0: "WISHLIST_EXCLUDE",
+		1: "WISHLIST_INCLUDE",
+		2: "WISHLIST_ONLY",
+	}
+	WishlistFilter_value = map[string]int32{
+		"WISHLIST_EXCLUDE": 0,
+		"WISHLIST_INCLUDE": 1,
+		"WISHLIST_ONLY":    2,
+	}
+)
+
+func (x WishlistFilter) Enum() *WishlistFilter {
+	p := new(WishlistFilter)
+	*p = x
+	return p
+}
+
+func (x WishlistFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WishlistFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_book_proto_enumTypes[2].Descriptor()
+}
+
+func (WishlistFilter) Type() protoreflect.EnumType {
+	return &file_book_proto_enumTypes[2]
+}
+
+func (x WishlistFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WishlistFilter.Descriptor instead.
+func (WishlistFilter) EnumDescriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{2}
+}
+
 type Book struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Isbn      string `protobuf:"bytes,2,opt,name=isbn,proto3" json:"isbn,omitempty"`
-	Title     string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Cover     string `protobuf:"bytes,4,opt,name=cover,proto3" json:"cover,omitempty"`
-	Author    string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
-	Published string `protobuf:"bytes,6,opt,name=published,proto3" json:"published,omitempty"`
-	Pages     int32  `protobuf:"varint,7,opt,name=pages,proto3" json:"pages,omitempty"`
-	Status    int32  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"` // 0-new, 1-reading, 2-finished,
+	Id              int32             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Isbn            string            `protobuf:"bytes,2,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Title           string            `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Cover           string            `protobuf:"bytes,4,opt,name=cover,proto3" json:"cover,omitempty"`
+	Author          string            `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	Published       string            `protobuf:"bytes,6,opt,name=published,proto3" json:"published,omitempty"`
+	Pages           int32             `protobuf:"varint,7,opt,name=pages,proto3" json:"pages,omitempty"`
+	Status          BookStatus        `protobuf:"varint,8,opt,name=status,proto3,enum=book_service.BookStatus" json:"status,omitempty"`
+	FieldSources    map[string]string `protobuf:"bytes,9,rep,name=field_sources,json=fieldSources,proto3" json:"field_sources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // field name -> metadata provider
+	Source          string            `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`                                                                                                                        // provider, manual
+	Authors         []*BookAuthor     `protobuf:"bytes,11,rep,name=authors,proto3" json:"authors,omitempty"`
+	CurrentPage     int32             `protobuf:"varint,12,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"` // of the current read
+	PercentComplete float32           `protobuf:"fixed32,13,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	EstimatedFinish string            `protobuf:"bytes,14,opt,name=estimated_finish,json=estimatedFinish,proto3" json:"estimated_finish,omitempty"` // YYYY-MM-DD, set by GetByID for books being read
+	Rating          float32           `protobuf:"fixed32,15,opt,name=rating,proto3" json:"rating,omitempty"`                                        // 0 when the book has no review
+	Tags            []*Tag            `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	Lent            bool              `protobuf:"varint,17,opt,name=lent,proto3" json:"lent,omitempty"` // a copy is lent out and not yet returned
+	Ownership       Ownership         `protobuf:"varint,18,opt,name=ownership,proto3,enum=book_service.Ownership" json:"ownership,omitempty"`
+	BorrowedFrom    string            `protobuf:"bytes,19,opt,name=borrowed_from,json=borrowedFrom,proto3" json:"borrowed_from,omitempty"`
+	ReturnBy        string            `protobuf:"bytes,20,opt,name=return_by,json=returnBy,proto3" json:"return_by,omitempty"`         // YYYY-MM-DD, empty when there is no deadline
+	Priority        int32             `protobuf:"varint,21,opt,name=priority,proto3" json:"priority,omitempty"`                        // wishlist only, higher is wanted more
+	WhereToBuy      string            `protobuf:"bytes,22,opt,name=where_to_buy,json=whereToBuy,proto3" json:"where_to_buy,omitempty"` // wishlist only
+	SeriesId        int32             `protobuf:"varint,23,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`        // 0 when the book is not part of a series
+	SeriesName      string            `protobuf:"bytes,24,opt,name=series_name,json=seriesName,proto3" json:"series_name,omitempty"`
+	SeriesPosition  float32           `protobuf:"fixed32,25,opt,name=series_position,json=seriesPosition,proto3" json:"series_position,omitempty"`
+	WorkId          int32             `protobuf:"varint,26,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"` // 0 when the provider knows of no work
+	WorkKey         string            `protobuf:"bytes,27,opt,name=work_key,json=workKey,proto3" json:"work_key,omitempty"`
+	EditionCount    int32             `protobuf:"varint,28,opt,name=edition_count,json=editionCount,proto3" json:"edition_count,omitempty"` // editions of the work on the shelf, 1 without a work
+	CopyCount       int32             `protobuf:"varint,29,opt,name=copy_count,json=copyCount,proto3" json:"copy_count,omitempty"`
+	ReadCount       int32             `protobuf:"varint,30,opt,name=read_count,json=readCount,proto3" json:"read_count,omitempty"` // finished read-throughs, DNF reads are not counted
 }
 
 func (x *Book) Reset() {
@@ -95,61 +266,1206 @@ func (x *Book) GetCover() string {
 	return ""
 }
 
-func (x *Book) GetAuthor() string {
+func (x *Book) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Book) GetPublished() string {
+	if x != nil {
+		return x.Published
+	}
+	return ""
+}
+
+func (x *Book) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *Book) GetStatus() BookStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookStatus_NEW
+}
+
+func (x *Book) GetFieldSources() map[string]string {
+	if x != nil {
+		return x.FieldSources
+	}
+	return nil
+}
+
+func (x *Book) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Book) GetAuthors() []*BookAuthor {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *Book) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *Book) GetPercentComplete() float32 {
+	if x != nil {
+		return x.PercentComplete
+	}
+	return 0
+}
+
+func (x *Book) GetEstimatedFinish() string {
+	if x != nil {
+		return x.EstimatedFinish
+	}
+	return ""
+}
+
+func (x *Book) GetRating() float32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Book) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Book) GetLent() bool {
+	if x != nil {
+		return x.Lent
+	}
+	return false
+}
+
+func (x *Book) GetOwnership() Ownership {
+	if x != nil {
+		return x.Ownership
+	}
+	return Ownership_OWNED
+}
+
+func (x *Book) GetBorrowedFrom() string {
+	if x != nil {
+		return x.BorrowedFrom
+	}
+	return ""
+}
+
+func (x *Book) GetReturnBy() string {
+	if x != nil {
+		return x.ReturnBy
+	}
+	return ""
+}
+
+func (x *Book) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Book) GetWhereToBuy() string {
+	if x != nil {
+		return x.WhereToBuy
+	}
+	return ""
+}
+
+func (x *Book) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *Book) GetSeriesName() string {
+	if x != nil {
+		return x.SeriesName
+	}
+	return ""
+}
+
+func (x *Book) GetSeriesPosition() float32 {
+	if x != nil {
+		return x.SeriesPosition
+	}
+	return 0
+}
+
+func (x *Book) GetWorkId() int32 {
+	if x != nil {
+		return x.WorkId
+	}
+	return 0
+}
+
+func (x *Book) GetWorkKey() string {
+	if x != nil {
+		return x.WorkKey
+	}
+	return ""
+}
+
+func (x *Book) GetEditionCount() int32 {
+	if x != nil {
+		return x.EditionCount
+	}
+	return 0
+}
+
+func (x *Book) GetCopyCount() int32 {
+	if x != nil {
+		return x.CopyCount
+	}
+	return 0
+}
+
+func (x *Book) GetReadCount() int32 {
+	if x != nil {
+		return x.ReadCount
+	}
+	return 0
+}
+
+type BookAuthor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role     string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // author, editor, translator
+	Position int32  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *BookAuthor) Reset() {
+	*x = BookAuthor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_book_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookAuthor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookAuthor) ProtoMessage() {}
+
+func (x *BookAuthor) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookAuthor.ProtoReflect.Descriptor instead.
+func (*BookAuthor) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BookAuthor) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BookAuthor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BookAuthor) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *BookAuthor) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type BookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data          []*BookData `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	IsOk          bool        `protobuf:"varint,2,opt,name=isOk,proto3" json:"isOk,omitempty"`
+	Message       string      `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	AverageRating float32     `protobuf:"fixed32,4,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"` // over every matching, rated book
+	Count         int64       `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	OwnedCount    int64       `protobuf:"varint,6,opt,name=owned_count,json=ownedCount,proto3" json:"owned_count,omitempty"` // matching books we own, borrowed ones excluded
+}
+
+func (x *BookResponse) Reset() {
+	*x = BookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_book_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookResponse) ProtoMessage() {}
+
+func (x *BookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookResponse.ProtoReflect.Descriptor instead.
+func (*BookResponse) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BookResponse) GetData() []*BookData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BookResponse) GetIsOk() bool {
+	if x != nil {
+		return x.IsOk
+	}
+	return false
+}
+
+func (x *BookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BookResponse) GetAverageRating() float32 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *BookResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BookResponse) GetOwnedCount() int64 {
+	if x != nil {
+		return x.OwnedCount
+	}
+	return 0
+}
+
+type BookResponseByItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data    []*Book `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	IsOk    bool    `protobuf:"varint,2,opt,name=isOk,proto3" json:"isOk,omitempty"`
+	Message string  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BookResponseByItem) Reset() {
+	*x = BookResponseByItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_book_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookResponseByItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookResponseByItem) ProtoMessage() {}
+
+func (x *BookResponseByItem) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookResponseByItem.ProtoReflect.Descriptor instead.
+func (*BookResponseByItem) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BookResponseByItem) GetData() []*Book {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BookResponseByItem) GetIsOk() bool {
+	if x != nil {
+		return x.IsOk
+	}
+	return false
+}
+
+func (x *BookResponseByItem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type OneBookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data    *BookData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	IsOk    bool      `protobuf:"varint,2,opt,name=isOk,proto3" json:"isOk,omitempty"`
+	Message string    `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *OneBookResponse) Reset() {
+	*x = OneBookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_book_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OneBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneBookResponse) ProtoMessage() {}
+
+func (x *OneBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneBookResponse.ProtoReflect.Descriptor instead.
+func (*OneBookResponse) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OneBookResponse) GetData() *BookData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *OneBookResponse) GetIsOk() bool {
+	if x != nil {
+		return x.IsOk
+	}
+	return false
+}
+
+func (x *OneBookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BookData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book            *Book      `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	Status          BookStatus `protobuf:"varint,2,opt,name=status,proto3,enum=book_service.BookStatus" json:"status,omitempty"`
+	CurrentPage     int32      `protobuf:"varint,3,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	PercentComplete float32    `protobuf:"fixed32,4,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+}
+
+func (x *BookData) Reset() {
+	*x = BookData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_book_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookData) ProtoMessage() {}
+
+func (x *BookData) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookData.ProtoReflect.Descriptor instead.
+func (*BookData) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BookData) GetBook() *Book {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+func (x *BookData) GetStatus() BookStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookStatus_NEW
+}
+
+func (x *BookData) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *BookData) GetPercentComplete() float32 {
+	if x != nil {
+		return x.PercentComplete
+	}
+	return 0
+}
+
+type CreateBook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Isbn string `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	// used when no metadata provider knows the isbn or skip_lookup is set
+	Title        string        `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Author       string        `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Published    string        `protobuf:"bytes,4,opt,name=published,proto3" json:"published,omitempty"`
+	Pages        int32         `protobuf:"varint,5,opt,name=pages,proto3" json:"pages,omitempty"`
+	Cover        string        `protobuf:"bytes,6,opt,name=cover,proto3" json:"cover,omitempty"`
+	SkipLookup   bool          `protobuf:"varint,7,opt,name=skip_lookup,json=skipLookup,proto3" json:"skip_lookup,omitempty"`
+	Authors      []*BookAuthor `protobuf:"bytes,8,rep,name=authors,proto3" json:"authors,omitempty"`
+	Ownership    Ownership     `protobuf:"varint,9,opt,name=ownership,proto3,enum=book_service.Ownership" json:"ownership,omitempty"`
+	BorrowedFrom string        `protobuf:"bytes,10,opt,name=borrowed_from,json=borrowedFrom,proto3" json:"borrowed_from,omitempty"`
+	ReturnBy     string        `protobuf:"bytes,11,opt,name=return_by,json=returnBy,proto3" json:"return_by,omitempty"` // YYYY-MM-DD
+	Priority     int32         `protobuf:"varint,12,opt,name=priority,proto3" json:"priority,omitempty"`
+	WhereToBuy   string        `protobuf:"bytes,13,opt,name=where_to_buy,json=whereToBuy,proto3" json:"where_to_buy,omitempty"`
+	// used when the metadata providers know of no series
+	Series         string  `protobuf:"bytes,14,opt,name=series,proto3" json:"series,omitempty"`
+	SeriesPosition float32 `protobuf:"fixed32,15,opt,name=series_position,json=seriesPosition,proto3" json:"series_position,omitempty"`
+}
+
+func (x *CreateBook) Reset() {
+	*x = CreateBook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_book_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBook) ProtoMessage() {}
+
+func (x *CreateBook) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBook.ProtoReflect.Descriptor instead.
+func (*CreateBook) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateBook) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *CreateBook) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateBook) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *CreateBook) GetPublished() string {
+	if x != nil {
+		return x.Published
+	}
+	return ""
+}
+
+func (x *CreateBook) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *CreateBook) GetCover() string {
+	if x != nil {
+		return x.Cover
+	}
+	return ""
+}
+
+func (x *CreateBook) GetSkipLookup() bool {
+	if x != nil {
+		return x.SkipLookup
+	}
+	return false
+}
+
+func (x *CreateBook) GetAuthors() []*BookAuthor {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *CreateBook) GetOwnership() Ownership {
+	if x != nil {
+		return x.Ownership
+	}
+	return Ownership_OWNED
+}
+
+func (x *CreateBook) GetBorrowedFrom() string {
+	if x != nil {
+		return x.BorrowedFrom
+	}
+	return ""
+}
+
+func (x *CreateBook) GetReturnBy() string {
+	if x != nil {
+		return x.ReturnBy
+	}
+	return ""
+}
+
+func (x *CreateBook) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateBook) GetWhereToBuy() string {
+	if x != nil {
+		return x.WhereToBuy
+	}
+	return ""
+}
+
+func (x *CreateBook) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *CreateBook) GetSeriesPosition() float32 {
+	if x != nil {
+		return x.SeriesPosition
+	}
+	return 0
+}
+
+type UpdateBook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Isbn      string        `protobuf:"bytes,2,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Title     string        `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Cover     string        `protobuf:"bytes,4,opt,name=cover,proto3" json:"cover,omitempty"`
+	Author    string        `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	Published string        `protobuf:"bytes,6,opt,name=published,proto3" json:"published,omitempty"`
+	Pages     int32         `protobuf:"varint,7,opt,name=pages,proto3" json:"pages,omitempty"`
+	Status    BookStatus    `protobuf:"varint,8,opt,name=status,proto3,enum=book_service.BookStatus" json:"status,omitempty"`
+	Authors   []*BookAuthor `protobuf:"bytes,9,rep,name=authors,proto3" json:"authors,omitempty"` // replaces the author list when set
+}
+
+func (x *UpdateBook) Reset() {
+	*x = UpdateBook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_book_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBook) ProtoMessage() {}
+
+func (x *UpdateBook) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBook.ProtoReflect.Descriptor instead.
+func (*UpdateBook) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateBook) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateBook) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *UpdateBook) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateBook) GetCover() string {
+	if x != nil {
+		return x.Cover
+	}
+	return ""
+}
+
+func (x *UpdateBook) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *UpdateBook) GetPublished() string {
+	if x != nil {
+		return x.Published
+	}
+	return ""
+}
+
+func (x *UpdateBook) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *UpdateBook) GetStatus() BookStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookStatus_NEW
+}
+
+func (x *UpdateBook) GetAuthors() []*BookAuthor {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+type UpdatePatchBook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Updpatch *BookData `protobuf:"bytes,2,opt,name=updpatch,proto3" json:"updpatch,omitempty"`
+}
+
+func (x *UpdatePatchBook) Reset() {
+	*x = UpdatePatchBook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_book_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePatchBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePatchBook) ProtoMessage() {}
+
+func (x *UpdatePatchBook) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePatchBook.ProtoReflect.Descriptor instead.
+func (*UpdatePatchBook) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdatePatchBook) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdatePatchBook) GetUpdpatch() *BookData {
+	if x != nil {
+		return x.Updpatch
+	}
+	return nil
+}
+
+type BookPK struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *BookPK) Reset() {
+	*x = BookPK{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_book_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookPK) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookPK) ProtoMessage() {}
+
+func (x *BookPK) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookPK.ProtoReflect.Descriptor instead.
+func (*BookPK) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BookPK) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteBookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Force bool  `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // delete even if a copy of the book is lent out
+}
+
+func (x *DeleteBookRequest) Reset() {
+	*x = DeleteBookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_book_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookRequest) ProtoMessage() {}
+
+func (x *DeleteBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBookRequest) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteBookRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteBookRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type BookByTitle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *BookByTitle) Reset() {
+	*x = BookByTitle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_book_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookByTitle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookByTitle) ProtoMessage() {}
+
+func (x *BookByTitle) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookByTitle.ProtoReflect.Descriptor instead.
+func (*BookByTitle) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BookByTitle) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type BookListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit          int32          `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32          `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Search         string         `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	AuthorId       int32          `protobuf:"varint,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorName     string         `protobuf:"bytes,5,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	MinRating      float32        `protobuf:"fixed32,6,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`
+	MaxRating      float32        `protobuf:"fixed32,7,opt,name=max_rating,json=maxRating,proto3" json:"max_rating,omitempty"`
+	SortBy         string         `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // created_at (default), rating, title, priority, series_position
+	Ascending      bool           `protobuf:"varint,9,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	AnyTagIds      []int32        `protobuf:"varint,10,rep,packed,name=any_tag_ids,json=anyTagIds,proto3" json:"any_tag_ids,omitempty"`      // books with at least one of these tags
+	AllTagIds      []int32        `protobuf:"varint,11,rep,packed,name=all_tag_ids,json=allTagIds,proto3" json:"all_tag_ids,omitempty"`      // books with every one of these tags
+	Wishlist       WishlistFilter `protobuf:"varint,12,opt,name=wishlist,proto3,enum=book_service.WishlistFilter" json:"wishlist,omitempty"` // wishlist books are left out by default
+	SeriesId       int32          `protobuf:"varint,13,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	CollapseByWork bool           `protobuf:"varint,14,opt,name=collapse_by_work,json=collapseByWork,proto3" json:"collapse_by_work,omitempty"` // one row per work, its earliest edition
+	LocationId     int32          `protobuf:"varint,15,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`               // books with a copy at this location
+}
+
+func (x *BookListRequest) Reset() {
+	*x = BookListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_book_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookListRequest) ProtoMessage() {}
+
+func (x *BookListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookListRequest.ProtoReflect.Descriptor instead.
+func (*BookListRequest) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BookListRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *BookListRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *BookListRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *BookListRequest) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *BookListRequest) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *BookListRequest) GetMinRating() float32 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+func (x *BookListRequest) GetMaxRating() float32 {
+	if x != nil {
+		return x.MaxRating
+	}
+	return 0
+}
+
+func (x *BookListRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *BookListRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+func (x *BookListRequest) GetAnyTagIds() []int32 {
+	if x != nil {
+		return x.AnyTagIds
+	}
+	return nil
+}
+
+func (x *BookListRequest) GetAllTagIds() []int32 {
+	if x != nil {
+		return x.AllTagIds
+	}
+	return nil
+}
+
+func (x *BookListRequest) GetWishlist() WishlistFilter {
 	if x != nil {
-		return x.Author
+		return x.Wishlist
 	}
-	return ""
+	return WishlistFilter_WISHLIST_EXCLUDE
 }
 
-func (x *Book) GetPublished() string {
+func (x *BookListRequest) GetSeriesId() int32 {
 	if x != nil {
-		return x.Published
+		return x.SeriesId
 	}
-	return ""
+	return 0
 }
 
-func (x *Book) GetPages() int32 {
+func (x *BookListRequest) GetCollapseByWork() bool {
 	if x != nil {
-		return x.Pages
+		return x.CollapseByWork
 	}
-	return 0
+	return false
 }
 
-func (x *Book) GetStatus() int32 {
+func (x *BookListRequest) GetLocationId() int32 {
 	if x != nil {
-		return x.Status
+		return x.LocationId
 	}
 	return 0
 }
 
-type BookResponse struct {
+type BookListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data    []*BookData `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	IsOk    bool        `protobuf:"varint,2,opt,name=isOk,proto3" json:"isOk,omitempty"`
-	Message string      `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Count         int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Books         []*Book `protobuf:"bytes,2,rep,name=books,proto3" json:"books,omitempty"`
+	AverageRating float32 `protobuf:"fixed32,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	OwnedCount    int64   `protobuf:"varint,4,opt,name=owned_count,json=ownedCount,proto3" json:"owned_count,omitempty"`
 }
 
-func (x *BookResponse) Reset() {
-	*x = BookResponse{}
+func (x *BookListResponse) Reset() {
+	*x = BookListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_book_proto_msgTypes[1]
+		mi := &file_book_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BookResponse) String() string {
+func (x *BookListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookResponse) ProtoMessage() {}
+func (*BookListResponse) ProtoMessage() {}
 
-func (x *BookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_book_proto_msgTypes[1]
+func (x *BookListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -160,59 +1476,69 @@ func (x *BookResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookResponse.ProtoReflect.Descriptor instead.
-func (*BookResponse) Descriptor() ([]byte, []int) {
-	return file_book_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use BookListResponse.ProtoReflect.Descriptor instead.
+func (*BookListResponse) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *BookResponse) GetData() []*BookData {
+func (x *BookListResponse) GetCount() int64 {
 	if x != nil {
-		return x.Data
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BookListResponse) GetBooks() []*Book {
+	if x != nil {
+		return x.Books
 	}
 	return nil
 }
 
-func (x *BookResponse) GetIsOk() bool {
+func (x *BookListResponse) GetAverageRating() float32 {
 	if x != nil {
-		return x.IsOk
+		return x.AverageRating
 	}
-	return false
+	return 0
 }
 
-func (x *BookResponse) GetMessage() string {
+func (x *BookListResponse) GetOwnedCount() int64 {
 	if x != nil {
-		return x.Message
+		return x.OwnedCount
 	}
-	return ""
+	return 0
 }
 
-type BookResponseByItem struct {
+type SetOwnershipRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data    []*Book `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	IsOk    bool    `protobuf:"varint,2,opt,name=isOk,proto3" json:"isOk,omitempty"`
-	Message string  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	BookId       int32     `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Ownership    Ownership `protobuf:"varint,2,opt,name=ownership,proto3,enum=book_service.Ownership" json:"ownership,omitempty"`
+	BorrowedFrom string    `protobuf:"bytes,3,opt,name=borrowed_from,json=borrowedFrom,proto3" json:"borrowed_from,omitempty"` // cleared unless borrowed
+	ReturnBy     string    `protobuf:"bytes,4,opt,name=return_by,json=returnBy,proto3" json:"return_by,omitempty"`             // YYYY-MM-DD, cleared unless borrowed
+	Priority     int32     `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`                            // cleared unless on the wishlist
+	WhereToBuy   string    `protobuf:"bytes,6,opt,name=where_to_buy,json=whereToBuy,proto3" json:"where_to_buy,omitempty"`     // cleared unless on the wishlist
 }
 
-func (x *BookResponseByItem) Reset() {
-	*x = BookResponseByItem{}
+func (x *SetOwnershipRequest) Reset() {
+	*x = SetOwnershipRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_book_proto_msgTypes[2]
+		mi := &file_book_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BookResponseByItem) String() string {
+func (x *SetOwnershipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookResponseByItem) ProtoMessage() {}
+func (*SetOwnershipRequest) ProtoMessage() {}
 
-func (x *BookResponseByItem) ProtoReflect() protoreflect.Message {
-	mi := &file_book_proto_msgTypes[2]
+func (x *SetOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -223,121 +1549,78 @@ func (x *BookResponseByItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookResponseByItem.ProtoReflect.Descriptor instead.
-func (*BookResponseByItem) Descriptor() ([]byte, []int) {
-	return file_book_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SetOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*SetOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *BookResponseByItem) GetData() []*Book {
+func (x *SetOwnershipRequest) GetBookId() int32 {
 	if x != nil {
-		return x.Data
+		return x.BookId
 	}
-	return nil
+	return 0
 }
 
-func (x *BookResponseByItem) GetIsOk() bool {
+func (x *SetOwnershipRequest) GetOwnership() Ownership {
 	if x != nil {
-		return x.IsOk
+		return x.Ownership
 	}
-	return false
+	return Ownership_OWNED
 }
 
-func (x *BookResponseByItem) GetMessage() string {
+func (x *SetOwnershipRequest) GetBorrowedFrom() string {
 	if x != nil {
-		return x.Message
+		return x.BorrowedFrom
 	}
 	return ""
 }
 
-type OneBookResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Data    *BookData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	IsOk    bool      `protobuf:"varint,2,opt,name=isOk,proto3" json:"isOk,omitempty"`
-	Message string    `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-}
-
-func (x *OneBookResponse) Reset() {
-	*x = OneBookResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_book_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *OneBookResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*OneBookResponse) ProtoMessage() {}
-
-func (x *OneBookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_book_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OneBookResponse.ProtoReflect.Descriptor instead.
-func (*OneBookResponse) Descriptor() ([]byte, []int) {
-	return file_book_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *OneBookResponse) GetData() *BookData {
+func (x *SetOwnershipRequest) GetReturnBy() string {
 	if x != nil {
-		return x.Data
+		return x.ReturnBy
 	}
-	return nil
+	return ""
 }
 
-func (x *OneBookResponse) GetIsOk() bool {
+func (x *SetOwnershipRequest) GetPriority() int32 {
 	if x != nil {
-		return x.IsOk
+		return x.Priority
 	}
-	return false
+	return 0
 }
 
-func (x *OneBookResponse) GetMessage() string {
+func (x *SetOwnershipRequest) GetWhereToBuy() string {
 	if x != nil {
-		return x.Message
+		return x.WhereToBuy
 	}
 	return ""
 }
 
-type BookData struct {
+type MoveToOwnedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Book   *Book `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
-	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	BookId int32 `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
 }
 
-func (x *BookData) Reset() {
-	*x = BookData{}
+func (x *MoveToOwnedRequest) Reset() {
+	*x = MoveToOwnedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_book_proto_msgTypes[4]
+		mi := &file_book_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BookData) String() string {
+func (x *MoveToOwnedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookData) ProtoMessage() {}
+func (*MoveToOwnedRequest) ProtoMessage() {}
 
-func (x *BookData) ProtoReflect() protoreflect.Message {
-	mi := &file_book_proto_msgTypes[4]
+func (x *MoveToOwnedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,50 +1631,45 @@ func (x *BookData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookData.ProtoReflect.Descriptor instead.
-func (*BookData) Descriptor() ([]byte, []int) {
-	return file_book_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *BookData) GetBook() *Book {
-	if x != nil {
-		return x.Book
-	}
-	return nil
+// Deprecated: Use MoveToOwnedRequest.ProtoReflect.Descriptor instead.
+func (*MoveToOwnedRequest) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *BookData) GetStatus() int32 {
+func (x *MoveToOwnedRequest) GetBookId() int32 {
 	if x != nil {
-		return x.Status
+		return x.BookId
 	}
 	return 0
 }
 
-type CreateBook struct {
+type DueSoonRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Isbn string `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Days   int32 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"` // how far ahead to look, defaults to 7; overdue books are always included
+	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func (x *CreateBook) Reset() {
-	*x = CreateBook{}
+func (x *DueSoonRequest) Reset() {
+	*x = DueSoonRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_book_proto_msgTypes[5]
+		mi := &file_book_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateBook) String() string {
+func (x *DueSoonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBook) ProtoMessage() {}
+func (*DueSoonRequest) ProtoMessage() {}
 
-func (x *CreateBook) ProtoReflect() protoreflect.Message {
-	mi := &file_book_proto_msgTypes[5]
+func (x *DueSoonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,50 +1680,59 @@ func (x *CreateBook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBook.ProtoReflect.Descriptor instead.
-func (*CreateBook) Descriptor() ([]byte, []int) {
-	return file_book_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DueSoonRequest.ProtoReflect.Descriptor instead.
+func (*DueSoonRequest) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateBook) GetIsbn() string {
+func (x *DueSoonRequest) GetDays() int32 {
 	if x != nil {
-		return x.Isbn
+		return x.Days
 	}
-	return ""
+	return 0
 }
 
-type UpdateBook struct {
+func (x *DueSoonRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *DueSoonRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type Author struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Isbn      string `protobuf:"bytes,2,opt,name=isbn,proto3" json:"isbn,omitempty"`
-	Title     string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Cover     string `protobuf:"bytes,4,opt,name=cover,proto3" json:"cover,omitempty"`
-	Author    string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
-	Published string `protobuf:"bytes,6,opt,name=published,proto3" json:"published,omitempty"`
-	Pages     int32  `protobuf:"varint,7,opt,name=pages,proto3" json:"pages,omitempty"`
-	Status    int32  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"` // 0-new, 1-reading, 2-finished,
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BookCount int32  `protobuf:"varint,3,opt,name=book_count,json=bookCount,proto3" json:"book_count,omitempty"`
 }
 
-func (x *UpdateBook) Reset() {
-	*x = UpdateBook{}
+func (x *Author) Reset() {
+	*x = Author{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_book_proto_msgTypes[6]
+		mi := &file_book_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateBook) String() string {
+func (x *Author) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBook) ProtoMessage() {}
+func (*Author) ProtoMessage() {}
 
-func (x *UpdateBook) ProtoReflect() protoreflect.Message {
-	mi := &file_book_proto_msgTypes[6]
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,93 +1743,59 @@ func (x *UpdateBook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBook.ProtoReflect.Descriptor instead.
-func (*UpdateBook) Descriptor() ([]byte, []int) {
-	return file_book_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdateBook) GetId() int32 {
+func (x *Author) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateBook) GetIsbn() string {
-	if x != nil {
-		return x.Isbn
-	}
-	return ""
-}
-
-func (x *UpdateBook) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *UpdateBook) GetCover() string {
-	if x != nil {
-		return x.Cover
-	}
-	return ""
-}
-
-func (x *UpdateBook) GetAuthor() string {
-	if x != nil {
-		return x.Author
-	}
-	return ""
-}
-
-func (x *UpdateBook) GetPublished() string {
+func (x *Author) GetName() string {
 	if x != nil {
-		return x.Published
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateBook) GetPages() int32 {
-	if x != nil {
-		return x.Pages
-	}
-	return 0
-}
-
-func (x *UpdateBook) GetStatus() int32 {
+func (x *Author) GetBookCount() int32 {
 	if x != nil {
-		return x.Status
+		return x.BookCount
 	}
 	return 0
 }
 
-type UpdatePatchBook struct {
+type AuthorListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int32     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Updpatch *BookData `protobuf:"bytes,2,opt,name=updpatch,proto3" json:"updpatch,omitempty"`
+	Limit  int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Search string `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
 }
 
-func (x *UpdatePatchBook) Reset() {
-	*x = UpdatePatchBook{}
+func (x *AuthorListRequest) Reset() {
+	*x = AuthorListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_book_proto_msgTypes[7]
+		mi := &file_book_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdatePatchBook) String() string {
+func (x *AuthorListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePatchBook) ProtoMessage() {}
+func (*AuthorListRequest) ProtoMessage() {}
 
-func (x *UpdatePatchBook) ProtoReflect() protoreflect.Message {
-	mi := &file_book_proto_msgTypes[7]
+func (x *AuthorListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,50 +1806,58 @@ func (x *UpdatePatchBook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePatchBook.ProtoReflect.Descriptor instead.
-func (*UpdatePatchBook) Descriptor() ([]byte, []int) {
-	return file_book_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use AuthorListRequest.ProtoReflect.Descriptor instead.
+func (*AuthorListRequest) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UpdatePatchBook) GetId() int32 {
+func (x *AuthorListRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Id
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *UpdatePatchBook) GetUpdpatch() *BookData {
+func (x *AuthorListRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Updpatch
+		return x.Offset
 	}
-	return nil
+	return 0
 }
 
-type BookPK struct {
+func (x *AuthorListRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type AuthorListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Count   int64     `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Authors []*Author `protobuf:"bytes,2,rep,name=authors,proto3" json:"authors,omitempty"`
 }
 
-func (x *BookPK) Reset() {
-	*x = BookPK{}
+func (x *AuthorListResponse) Reset() {
+	*x = AuthorListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_book_proto_msgTypes[8]
+		mi := &file_book_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BookPK) String() string {
+func (x *AuthorListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookPK) ProtoMessage() {}
+func (*AuthorListResponse) ProtoMessage() {}
 
-func (x *BookPK) ProtoReflect() protoreflect.Message {
-	mi := &file_book_proto_msgTypes[8]
+func (x *AuthorListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,43 +1868,56 @@ func (x *BookPK) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookPK.ProtoReflect.Descriptor instead.
-func (*BookPK) Descriptor() ([]byte, []int) {
-	return file_book_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use AuthorListResponse.ProtoReflect.Descriptor instead.
+func (*AuthorListResponse) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *BookPK) GetId() int32 {
+func (x *AuthorListResponse) GetCount() int64 {
 	if x != nil {
-		return x.Id
+		return x.Count
 	}
 	return 0
 }
 
-type BookByTitle struct {
+func (x *AuthorListResponse) GetAuthors() []*Author {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+type StatusChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Id         int32      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookId     int32      `protobuf:"varint,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	FromStatus BookStatus `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=book_service.BookStatus" json:"from_status,omitempty"`
+	ToStatus   BookStatus `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=book_service.BookStatus" json:"to_status,omitempty"`
+	ChangedAt  string     `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	Initial    bool       `protobuf:"varint,6,opt,name=initial,proto3" json:"initial,omitempty"` // set for the entry written when the book was added
+	Dnf        bool       `protobuf:"varint,7,opt,name=dnf,proto3" json:"dnf,omitempty"`         // set when the change gave up a read, which is no finish even if to_status is FINISHED
 }
 
-func (x *BookByTitle) Reset() {
-	*x = BookByTitle{}
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_book_proto_msgTypes[9]
+		mi := &file_book_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BookByTitle) String() string {
+func (x *StatusChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookByTitle) ProtoMessage() {}
+func (*StatusChange) ProtoMessage() {}
 
-func (x *BookByTitle) ProtoReflect() protoreflect.Message {
-	mi := &file_book_proto_msgTypes[9]
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,45 +1928,85 @@ func (x *BookByTitle) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookByTitle.ProtoReflect.Descriptor instead.
-func (*BookByTitle) Descriptor() ([]byte, []int) {
-	return file_book_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *BookByTitle) GetTitle() string {
+func (x *StatusChange) GetId() int32 {
 	if x != nil {
-		return x.Title
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StatusChange) GetBookId() int32 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *StatusChange) GetFromStatus() BookStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return BookStatus_NEW
+}
+
+func (x *StatusChange) GetToStatus() BookStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return BookStatus_NEW
+}
+
+func (x *StatusChange) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
 	}
 	return ""
 }
 
-type BookListRequest struct {
+func (x *StatusChange) GetInitial() bool {
+	if x != nil {
+		return x.Initial
+	}
+	return false
+}
+
+func (x *StatusChange) GetDnf() bool {
+	if x != nil {
+		return x.Dnf
+	}
+	return false
+}
+
+type StatusHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit  int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Search string `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	Changes []*StatusChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *BookListRequest) Reset() {
-	*x = BookListRequest{}
+func (x *StatusHistoryResponse) Reset() {
+	*x = StatusHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_book_proto_msgTypes[10]
+		mi := &file_book_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BookListRequest) String() string {
+func (x *StatusHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookListRequest) ProtoMessage() {}
+func (*StatusHistoryResponse) ProtoMessage() {}
 
-func (x *BookListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_book_proto_msgTypes[10]
+func (x *StatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,58 +2017,47 @@ func (x *BookListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookListRequest.ProtoReflect.Descriptor instead.
-func (*BookListRequest) Descriptor() ([]byte, []int) {
-	return file_book_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *BookListRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *BookListRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+// Deprecated: Use StatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*StatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *BookListRequest) GetSearch() string {
+func (x *StatusHistoryResponse) GetChanges() []*StatusChange {
 	if x != nil {
-		return x.Search
+		return x.Changes
 	}
-	return ""
+	return nil
 }
 
-type BookListResponse struct {
+type UpdateProgressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Books []*Book `protobuf:"bytes,2,rep,name=books,proto3" json:"books,omitempty"`
+	BookId int32 `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	// Types that are assignable to Progress:
+	//	*UpdateProgressRequest_Page
+	//	*UpdateProgressRequest_Percent
+	Progress isUpdateProgressRequest_Progress `protobuf_oneof:"progress"`
 }
 
-func (x *BookListResponse) Reset() {
-	*x = BookListResponse{}
+func (x *UpdateProgressRequest) Reset() {
+	*x = UpdateProgressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_book_proto_msgTypes[11]
+		mi := &file_book_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BookListResponse) String() string {
+func (x *UpdateProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookListResponse) ProtoMessage() {}
+func (*UpdateProgressRequest) ProtoMessage() {}
 
-func (x *BookListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_book_proto_msgTypes[11]
+func (x *UpdateProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -765,103 +2068,353 @@ func (x *BookListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookListResponse.ProtoReflect.Descriptor instead.
-func (*BookListResponse) Descriptor() ([]byte, []int) {
-	return file_book_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UpdateProgressRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProgressRequest) Descriptor() ([]byte, []int) {
+	return file_book_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *BookListResponse) GetCount() int64 {
+func (x *UpdateProgressRequest) GetBookId() int32 {
 	if x != nil {
-		return x.Count
+		return x.BookId
 	}
 	return 0
 }
 
-func (x *BookListResponse) GetBooks() []*Book {
-	if x != nil {
-		return x.Books
+func (m *UpdateProgressRequest) GetProgress() isUpdateProgressRequest_Progress {
+	if m != nil {
+		return m.Progress
 	}
 	return nil
 }
 
+func (x *UpdateProgressRequest) GetPage() int32 {
+	if x, ok := x.GetProgress().(*UpdateProgressRequest_Page); ok {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *UpdateProgressRequest) GetPercent() float32 {
+	if x, ok := x.GetProgress().(*UpdateProgressRequest_Percent); ok {
+		return x.Percent
+	}
+	return 0
+}
+
+type isUpdateProgressRequest_Progress interface {
+	isUpdateProgressRequest_Progress()
+}
+
+type UpdateProgressRequest_Page struct {
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3,oneof"`
+}
+
+type UpdateProgressRequest_Percent struct {
+	Percent float32 `protobuf:"fixed32,3,opt,name=percent,proto3,oneof"`
+}
+
+func (*UpdateProgressRequest_Page) isUpdateProgressRequest_Progress() {}
+
+func (*UpdateProgressRequest_Percent) isUpdateProgressRequest_Progress() {}
+
 var File_book_proto protoreflect.FileDescriptor
 
 var file_book_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x62, 0x6f,
-	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x04, 0x42,
-	0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x67,
-	0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x68, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x6a, 0x0a, 0x12, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69,
-	0x73, 0x4f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6b, 0x0a,
-	0x0f, 0x4f, 0x6e, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f,
-	0x6f, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
-	0x69, 0x73, 0x4f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6b,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4a, 0x0a, 0x08, 0x42, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x09, 0x74, 0x61, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xad, 0x08, 0x0a, 0x04, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73,
+	0x62, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x49, 0x0a, 0x0d,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x32, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x65, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x65, 0x6e, 0x74, 0x12,
+	0x35, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x09, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77,
+	0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62,
+	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x77, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x74, 0x6f,
+	0x5f, 0x62, 0x75, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x68, 0x65, 0x72,
+	0x65, 0x54, 0x6f, 0x42, 0x75, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x5f, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65,
+	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x70, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3f, 0x0a, 0x11, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x60, 0x0a, 0x0a, 0x42, 0x6f, 0x6f, 0x6b, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc6, 0x01, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x6a, 0x0a, 0x12, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73,
+	0x4f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6b, 0x0a, 0x0f,
+	0x4f, 0x6e, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x69,
+	0x73, 0x4f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x08, 0x42, 0x6f,
 	0x6f, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x20, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x22, 0xc0, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
-	0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x30,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0xe5,
+	0x03, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x73, 0x62, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x62,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61,
-	0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x55, 0x0a, 0x0f, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32,
-	0x0a, 0x08, 0x75, 0x70, 0x64, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x75, 0x70, 0x64, 0x70, 0x61, 0x74,
-	0x63, 0x68, 0x22, 0x18, 0x0a, 0x06, 0x42, 0x6f, 0x6f, 0x6b, 0x50, 0x4b, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x0b,
-	0x42, 0x6f, 0x6f, 0x6b, 0x42, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x22, 0x57, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x52, 0x0a, 0x10, 0x42, 0x6f,
-	0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x42, 0x17,
-	0x5a, 0x15, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69,
+	0x70, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x73, 0x6b, 0x69, 0x70, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x35,
+	0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6f,
+	0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x77, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x5f,
+	0x62, 0x75, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x68, 0x65, 0x72, 0x65,
+	0x54, 0x6f, 0x42, 0x75, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x02, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x07,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x22, 0x55, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x75, 0x70,
+	0x64, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x75, 0x70, 0x64, 0x70, 0x61, 0x74, 0x63, 0x68, 0x22, 0x18,
+	0x0a, 0x06, 0x42, 0x6f, 0x6f, 0x6b, 0x50, 0x4b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x22, 0x23, 0x0a, 0x0b, 0x42, 0x6f, 0x6f, 0x6b, 0x42, 0x79, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0xec, 0x03, 0x0a, 0x0f, 0x42, 0x6f, 0x6f,
+	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x61, 0x67,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x61, 0x6e, 0x79, 0x54,
+	0x61, 0x67, 0x49, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x61, 0x67,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x54,
+	0x61, 0x67, 0x49, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x77, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x08, 0x77, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65,
+	0x42, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe5, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62,
+	0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x23, 0x0a, 0x0d,
+	0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x77, 0x68,
+	0x65, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x75, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x68, 0x65, 0x72, 0x65, 0x54, 0x6f, 0x42, 0x75, 0x79, 0x22, 0x2d, 0x0a, 0x12,
+	0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x0e, 0x44,
+	0x75, 0x65, 0x53, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x4b, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x59, 0x0a, 0x11,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x5a, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x73, 0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x66, 0x72,
+	0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6e, 0x66, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64, 0x6e, 0x66, 0x22, 0x4d, 0x0a, 0x15, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x1a, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x00, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x0a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2a, 0x30, 0x0a, 0x0a, 0x42, 0x6f, 0x6f,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x57, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x32, 0x0a, 0x09, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x57, 0x4e, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x4f, 0x52, 0x52, 0x4f, 0x57, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x49, 0x53, 0x48, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x02, 0x2a,
+	0x4f, 0x0a, 0x0e, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x49, 0x53, 0x48, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x45, 0x58,
+	0x43, 0x4c, 0x55, 0x44, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x49, 0x53, 0x48, 0x4c,
+	0x49, 0x53, 0x54, 0x5f, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a,
+	0x0d, 0x57, 0x49, 0x53, 0x48, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02,
+	0x42, 0x17, 0x5a, 0x15, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -876,33 +2429,66 @@ func file_book_proto_rawDescGZIP() []byte {
 	return file_book_proto_rawDescData
 }
 
-var file_book_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_book_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_book_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_book_proto_goTypes = []interface{}{
-	(*Book)(nil),               // 0: book_service.Book
-	(*BookResponse)(nil),       // 1: book_service.BookResponse
-	(*BookResponseByItem)(nil), // 2: book_service.BookResponseByItem
-	(*OneBookResponse)(nil),    // 3: book_service.OneBookResponse
-	(*BookData)(nil),           // 4: book_service.BookData
-	(*CreateBook)(nil),         // 5: book_service.CreateBook
-	(*UpdateBook)(nil),         // 6: book_service.UpdateBook
-	(*UpdatePatchBook)(nil),    // 7: book_service.UpdatePatchBook
-	(*BookPK)(nil),             // 8: book_service.BookPK
-	(*BookByTitle)(nil),        // 9: book_service.BookByTitle
-	(*BookListRequest)(nil),    // 10: book_service.BookListRequest
-	(*BookListResponse)(nil),   // 11: book_service.BookListResponse
+	(BookStatus)(0),               // 0: book_service.BookStatus
+	(Ownership)(0),                // 1: book_service.Ownership
+	(WishlistFilter)(0),           // 2: book_service.WishlistFilter
+	(*Book)(nil),                  // 3: book_service.Book
+	(*BookAuthor)(nil),            // 4: book_service.BookAuthor
+	(*BookResponse)(nil),          // 5: book_service.BookResponse
+	(*BookResponseByItem)(nil),    // 6: book_service.BookResponseByItem
+	(*OneBookResponse)(nil),       // 7: book_service.OneBookResponse
+	(*BookData)(nil),              // 8: book_service.BookData
+	(*CreateBook)(nil),            // 9: book_service.CreateBook
+	(*UpdateBook)(nil),            // 10: book_service.UpdateBook
+	(*UpdatePatchBook)(nil),       // 11: book_service.UpdatePatchBook
+	(*BookPK)(nil),                // 12: book_service.BookPK
+	(*DeleteBookRequest)(nil),     // 13: book_service.DeleteBookRequest
+	(*BookByTitle)(nil),           // 14: book_service.BookByTitle
+	(*BookListRequest)(nil),       // 15: book_service.BookListRequest
+	(*BookListResponse)(nil),      // 16: book_service.BookListResponse
+	(*SetOwnershipRequest)(nil),   // 17: book_service.SetOwnershipRequest
+	(*MoveToOwnedRequest)(nil),    // 18: book_service.MoveToOwnedRequest
+	(*DueSoonRequest)(nil),        // 19: book_service.DueSoonRequest
+	(*Author)(nil),                // 20: book_service.Author
+	(*AuthorListRequest)(nil),     // 21: book_service.AuthorListRequest
+	(*AuthorListResponse)(nil),    // 22: book_service.AuthorListResponse
+	(*StatusChange)(nil),          // 23: book_service.StatusChange
+	(*StatusHistoryResponse)(nil), // 24: book_service.StatusHistoryResponse
+	(*UpdateProgressRequest)(nil), // 25: book_service.UpdateProgressRequest
+	nil,                           // 26: book_service.Book.FieldSourcesEntry
+	(*Tag)(nil),                   // 27: book_service.Tag
 }
 var file_book_proto_depIdxs = []int32{
-	4, // 0: book_service.BookResponse.data:type_name -> book_service.BookData
-	0, // 1: book_service.BookResponseByItem.data:type_name -> book_service.Book
-	4, // 2: book_service.OneBookResponse.data:type_name -> book_service.BookData
-	0, // 3: book_service.BookData.book:type_name -> book_service.Book
-	4, // 4: book_service.UpdatePatchBook.updpatch:type_name -> book_service.BookData
-	0, // 5: book_service.BookListResponse.books:type_name -> book_service.Book
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: book_service.Book.status:type_name -> book_service.BookStatus
+	26, // 1: book_service.Book.field_sources:type_name -> book_service.Book.FieldSourcesEntry
+	4,  // 2: book_service.Book.authors:type_name -> book_service.BookAuthor
+	27, // 3: book_service.Book.tags:type_name -> book_service.Tag
+	1,  // 4: book_service.Book.ownership:type_name -> book_service.Ownership
+	8,  // 5: book_service.BookResponse.data:type_name -> book_service.BookData
+	3,  // 6: book_service.BookResponseByItem.data:type_name -> book_service.Book
+	8,  // 7: book_service.OneBookResponse.data:type_name -> book_service.BookData
+	3,  // 8: book_service.BookData.book:type_name -> book_service.Book
+	0,  // 9: book_service.BookData.status:type_name -> book_service.BookStatus
+	4,  // 10: book_service.CreateBook.authors:type_name -> book_service.BookAuthor
+	1,  // 11: book_service.CreateBook.ownership:type_name -> book_service.Ownership
+	0,  // 12: book_service.UpdateBook.status:type_name -> book_service.BookStatus
+	4,  // 13: book_service.UpdateBook.authors:type_name -> book_service.BookAuthor
+	8,  // 14: book_service.UpdatePatchBook.updpatch:type_name -> book_service.BookData
+	2,  // 15: book_service.BookListRequest.wishlist:type_name -> book_service.WishlistFilter
+	3,  // 16: book_service.BookListResponse.books:type_name -> book_service.Book
+	1,  // 17: book_service.SetOwnershipRequest.ownership:type_name -> book_service.Ownership
+	20, // 18: book_service.AuthorListResponse.authors:type_name -> book_service.Author
+	0,  // 19: book_service.StatusChange.from_status:type_name -> book_service.BookStatus
+	0,  // 20: book_service.StatusChange.to_status:type_name -> book_service.BookStatus
+	23, // 21: book_service.StatusHistoryResponse.changes:type_name -> book_service.StatusChange
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_book_proto_init() }
@@ -910,6 +2496,7 @@ func file_book_proto_init() {
 	if File_book_proto != nil {
 		return
 	}
+	file_tag_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_book_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Book); i {
@@ -924,7 +2511,7 @@ func file_book_proto_init() {
 			}
 		}
 		file_book_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BookResponse); i {
+			switch v := v.(*BookAuthor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -936,7 +2523,7 @@ func file_book_proto_init() {
 			}
 		}
 		file_book_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BookResponseByItem); i {
+			switch v := v.(*BookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -948,7 +2535,7 @@ func file_book_proto_init() {
 			}
 		}
 		file_book_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OneBookResponse); i {
+			switch v := v.(*BookResponseByItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -960,7 +2547,7 @@ func file_book_proto_init() {
 			}
 		}
 		file_book_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BookData); i {
+			switch v := v.(*OneBookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -972,7 +2559,7 @@ func file_book_proto_init() {
 			}
 		}
 		file_book_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBook); i {
+			switch v := v.(*BookData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -984,7 +2571,7 @@ func file_book_proto_init() {
 			}
 		}
 		file_book_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBook); i {
+			switch v := v.(*CreateBook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -996,7 +2583,7 @@ func file_book_proto_init() {
 			}
 		}
 		file_book_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePatchBook); i {
+			switch v := v.(*UpdateBook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1008,7 +2595,7 @@ func file_book_proto_init() {
 			}
 		}
 		file_book_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BookPK); i {
+			switch v := v.(*UpdatePatchBook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1020,7 +2607,7 @@ func file_book_proto_init() {
 			}
 		}
 		file_book_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BookByTitle); i {
+			switch v := v.(*BookPK); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1032,7 +2619,7 @@ func file_book_proto_init() {
 			}
 		}
 		file_book_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BookListRequest); i {
+			switch v := v.(*DeleteBookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1044,6 +2631,30 @@ func file_book_proto_init() {
 			}
 		}
 		file_book_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookByTitle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_book_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_book_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BookListResponse); i {
 			case 0:
 				return &v.state
@@ -1055,19 +2666,132 @@ func file_book_proto_init() {
 				return nil
 			}
 		}
+		file_book_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOwnershipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_book_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveToOwnedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_book_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DueSoonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_book_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Author); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_book_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_book_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_book_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_book_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_book_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_book_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*UpdateProgressRequest_Page)(nil),
+		(*UpdateProgressRequest_Percent)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_book_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      3,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_book_proto_goTypes,
 		DependencyIndexes: file_book_proto_depIdxs,
+		EnumInfos:         file_book_proto_enumTypes,
 		MessageInfos:      file_book_proto_msgTypes,
 	}.Build()
 	File_book_proto = out.File
@@ -7,9 +7,9 @@
 package book_service
 
 import (
-	_ "github.com/golang/protobuf/ptypes/empty"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 )
 
@@ -27,74 +27,582 @@ var file_book_service_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xe9, 0x03, 0x0a, 0x0b,
-	0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x1a,
+	0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x09, 0x74, 0x61, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x63, 0x6f, 0x70,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x32, 0xd6, 0x27, 0x0a, 0x0b, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x6e, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42,
+	0x79, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x50, 0x4b, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x1a, 0x12, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x22,
+	0x00, 0x12, 0x4d, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x1a,
 	0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f,
 	0x6e, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x35, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x62, 0x6f,
-	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x50,
-	0x4b, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x38, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
-	0x6f, 0x6f, 0x6b, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0b, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x74, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x6e, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x12, 0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x50, 0x4b, 0x1a, 0x1a, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f,
-	0x6b, 0x42, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x42, 0x79, 0x54, 0x69,
-	0x74, 0x6c, 0x65, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x79, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x00, 0x42, 0x17, 0x5a, 0x15, 0x67, 0x65, 0x6e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x47, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x42, 0x6f, 0x6f, 0x6b, 0x42, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x42,
+	0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6b, 0x50, 0x4b, 0x1a, 0x23, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4f, 0x6e, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x12, 0x21, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0b,
+	0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x12, 0x20, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54,
+	0x6f, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6b, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x65, 0x53, 0x6f,
+	0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x44, 0x75, 0x65, 0x53, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0a, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x21, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x21, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x16, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x4b, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x61, 0x67, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x13, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x50, 0x4b, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x08, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x54,
+	0x61, 0x67, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x65, 0x6c,
+	0x66, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12,
+	0x15, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x68, 0x65, 0x6c, 0x66, 0x50, 0x4b, 0x1a, 0x13, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x22, 0x00, 0x12, 0x50, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x65, 0x6c,
+	0x66, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x65, 0x6c,
+	0x66, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x20,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x68, 0x65, 0x6c, 0x66, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x15, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x50, 0x4b, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x42, 0x6f,
+	0x6f, 0x6b, 0x54, 0x6f, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x42, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f,
+	0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x42,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0f, 0x4d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x4f, 0x6e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x22, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66,
+	0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x65,
+	0x6c, 0x66, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x42, 0x6f, 0x6f, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x42, 0x6f, 0x6f,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x07,
+	0x53, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x4b, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x6f,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x4b,
+	0x1a, 0x1a, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x6f, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x12, 0x3d,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x00, 0x12, 0x43, 0x0a,
+	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x65,
+	0x22, 0x00, 0x12, 0x35, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74,
+	0x65, 0x50, 0x4b, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x22, 0x00, 0x12, 0x3c,
+	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x65,
+	0x50, 0x4b, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6b, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a,
+	0x08, 0x4c, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x43,
+	0x0a, 0x0a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x1f, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61,
+	0x6e, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73,
+	0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x6f, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x6f, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x49, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x21, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x4b, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0a, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0d,
+	0x53, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x22, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74,
+	0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x4e, 0x65, 0x78, 0x74, 0x49,
+	0x6e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x4b, 0x1a,
+	0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6b, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x12, 0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x50, 0x4b, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x49, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x22, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x44, 0x0a,
+	0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x4b, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x07, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x22, 0x00, 0x12,
+	0x43, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x1f, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f,
+	0x70, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x70, 0x79, 0x12, 0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x50, 0x4b, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x70, 0x69, 0x65, 0x73,
+	0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x6f, 0x70, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x6f, 0x70, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3f, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x1d, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x76,
+	0x65, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x70, 0x79,
+	0x22, 0x00, 0x12, 0x39, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x72, 0x65, 0x61,
+	0x64, 0x12, 0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x50, 0x4b, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x22, 0x00, 0x12, 0x39, 0x0a,
+	0x0b, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x12, 0x14, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b,
+	0x50, 0x4b, 0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x61, 0x64, 0x73, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x17, 0x5a, 0x15,
+	0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_book_service_proto_goTypes = []interface{}{
-	(*CreateBook)(nil),         // 0: book_service.CreateBook
-	(*BookPK)(nil),             // 1: book_service.BookPK
-	(*BookListRequest)(nil),    // 2: book_service.BookListRequest
-	(*UpdateBook)(nil),         // 3: book_service.UpdateBook
-	(*UpdatePatchBook)(nil),    // 4: book_service.UpdatePatchBook
-	(*BookByTitle)(nil),        // 5: book_service.BookByTitle
-	(*OneBookResponse)(nil),    // 6: book_service.OneBookResponse
-	(*Book)(nil),               // 7: book_service.Book
-	(*BookResponse)(nil),       // 8: book_service.BookResponse
-	(*BookResponseByItem)(nil), // 9: book_service.BookResponseByItem
+	(*CreateBook)(nil),            // 0: book_service.CreateBook
+	(*BookPK)(nil),                // 1: book_service.BookPK
+	(*BookListRequest)(nil),       // 2: book_service.BookListRequest
+	(*UpdateBook)(nil),            // 3: book_service.UpdateBook
+	(*UpdatePatchBook)(nil),       // 4: book_service.UpdatePatchBook
+	(*DeleteBookRequest)(nil),     // 5: book_service.DeleteBookRequest
+	(*BookByTitle)(nil),           // 6: book_service.BookByTitle
+	(*UpdateProgressRequest)(nil), // 7: book_service.UpdateProgressRequest
+	(*SetOwnershipRequest)(nil),   // 8: book_service.SetOwnershipRequest
+	(*MoveToOwnedRequest)(nil),    // 9: book_service.MoveToOwnedRequest
+	(*DueSoonRequest)(nil),        // 10: book_service.DueSoonRequest
+	(*StartSessionRequest)(nil),   // 11: book_service.StartSessionRequest
+	(*EndSessionRequest)(nil),     // 12: book_service.EndSessionRequest
+	(*SessionListRequest)(nil),    // 13: book_service.SessionListRequest
+	(*CreateReviewRequest)(nil),   // 14: book_service.CreateReviewRequest
+	(*UpdateReviewRequest)(nil),   // 15: book_service.UpdateReviewRequest
+	(*ReviewPK)(nil),              // 16: book_service.ReviewPK
+	(*ReviewListRequest)(nil),     // 17: book_service.ReviewListRequest
+	(*CreateTagRequest)(nil),      // 18: book_service.CreateTagRequest
+	(*RenameTagRequest)(nil),      // 19: book_service.RenameTagRequest
+	(*MergeTagsRequest)(nil),      // 20: book_service.MergeTagsRequest
+	(*TagPK)(nil),                 // 21: book_service.TagPK
+	(*TagListRequest)(nil),        // 22: book_service.TagListRequest
+	(*SetBookTagsRequest)(nil),    // 23: book_service.SetBookTagsRequest
+	(*CreateShelfRequest)(nil),    // 24: book_service.CreateShelfRequest
+	(*ShelfPK)(nil),               // 25: book_service.ShelfPK
+	(*ShelfListRequest)(nil),      // 26: book_service.ShelfListRequest
+	(*UpdateShelfRequest)(nil),    // 27: book_service.UpdateShelfRequest
+	(*ShelfBookRequest)(nil),      // 28: book_service.ShelfBookRequest
+	(*MoveShelfBookRequest)(nil),  // 29: book_service.MoveShelfBookRequest
+	(*ShelfBooksRequest)(nil),     // 30: book_service.ShelfBooksRequest
+	(*SetGoalRequest)(nil),        // 31: book_service.SetGoalRequest
+	(*GoalListRequest)(nil),       // 32: book_service.GoalListRequest
+	(*GoalPK)(nil),                // 33: book_service.GoalPK
+	(*StatsRequest)(nil),          // 34: book_service.StatsRequest
+	(*CreateNoteRequest)(nil),     // 35: book_service.CreateNoteRequest
+	(*NotePK)(nil),                // 36: book_service.NotePK
+	(*UpdateNoteRequest)(nil),     // 37: book_service.UpdateNoteRequest
+	(*BookNotesRequest)(nil),      // 38: book_service.BookNotesRequest
+	(*NoteListRequest)(nil),       // 39: book_service.NoteListRequest
+	(*LendBookRequest)(nil),       // 40: book_service.LendBookRequest
+	(*ReturnBookRequest)(nil),     // 41: book_service.ReturnBookRequest
+	(*LoanListRequest)(nil),       // 42: book_service.LoanListRequest
+	(*CreateSeriesRequest)(nil),   // 43: book_service.CreateSeriesRequest
+	(*UpdateSeriesRequest)(nil),   // 44: book_service.UpdateSeriesRequest
+	(*SeriesPK)(nil),              // 45: book_service.SeriesPK
+	(*SeriesListRequest)(nil),     // 46: book_service.SeriesListRequest
+	(*SetBookSeriesRequest)(nil),  // 47: book_service.SetBookSeriesRequest
+	(*WorkPK)(nil),                // 48: book_service.WorkPK
+	(*EditionListRequest)(nil),    // 49: book_service.EditionListRequest
+	(*SetWorkStatusRequest)(nil),  // 50: book_service.SetWorkStatusRequest
+	(*CreateLocationRequest)(nil), // 51: book_service.CreateLocationRequest
+	(*UpdateLocationRequest)(nil), // 52: book_service.UpdateLocationRequest
+	(*LocationPK)(nil),            // 53: book_service.LocationPK
+	(*LocationListRequest)(nil),   // 54: book_service.LocationListRequest
+	(*CreateCopyRequest)(nil),     // 55: book_service.CreateCopyRequest
+	(*UpdateCopyRequest)(nil),     // 56: book_service.UpdateCopyRequest
+	(*CopyPK)(nil),                // 57: book_service.CopyPK
+	(*CopyListRequest)(nil),       // 58: book_service.CopyListRequest
+	(*MoveCopyRequest)(nil),       // 59: book_service.MoveCopyRequest
+	(*ReadListRequest)(nil),       // 60: book_service.ReadListRequest
+	(*AuthorListRequest)(nil),     // 61: book_service.AuthorListRequest
+	(*OneBookResponse)(nil),       // 62: book_service.OneBookResponse
+	(*Book)(nil),                  // 63: book_service.Book
+	(*BookResponse)(nil),          // 64: book_service.BookResponse
+	(*BookResponseByItem)(nil),    // 65: book_service.BookResponseByItem
+	(*StatusHistoryResponse)(nil), // 66: book_service.StatusHistoryResponse
+	(*BookListResponse)(nil),      // 67: book_service.BookListResponse
+	(*ReadingSession)(nil),        // 68: book_service.ReadingSession
+	(*SessionListResponse)(nil),   // 69: book_service.SessionListResponse
+	(*Review)(nil),                // 70: book_service.Review
+	(*emptypb.Empty)(nil),         // 71: google.protobuf.Empty
+	(*ReviewListResponse)(nil),    // 72: book_service.ReviewListResponse
+	(*Tag)(nil),                   // 73: book_service.Tag
+	(*TagListResponse)(nil),       // 74: book_service.TagListResponse
+	(*Shelf)(nil),                 // 75: book_service.Shelf
+	(*ShelfListResponse)(nil),     // 76: book_service.ShelfListResponse
+	(*ShelfBooksResponse)(nil),    // 77: book_service.ShelfBooksResponse
+	(*Goal)(nil),                  // 78: book_service.Goal
+	(*GoalListResponse)(nil),      // 79: book_service.GoalListResponse
+	(*GoalProgress)(nil),          // 80: book_service.GoalProgress
+	(*Stats)(nil),                 // 81: book_service.Stats
+	(*Note)(nil),                  // 82: book_service.Note
+	(*NoteListResponse)(nil),      // 83: book_service.NoteListResponse
+	(*Loan)(nil),                  // 84: book_service.Loan
+	(*LoanListResponse)(nil),      // 85: book_service.LoanListResponse
+	(*Series)(nil),                // 86: book_service.Series
+	(*SeriesListResponse)(nil),    // 87: book_service.SeriesListResponse
+	(*Work)(nil),                  // 88: book_service.Work
+	(*Location)(nil),              // 89: book_service.Location
+	(*LocationListResponse)(nil),  // 90: book_service.LocationListResponse
+	(*Copy)(nil),                  // 91: book_service.Copy
+	(*CopyListResponse)(nil),      // 92: book_service.CopyListResponse
+	(*Read)(nil),                  // 93: book_service.Read
+	(*ReadListResponse)(nil),      // 94: book_service.ReadListResponse
+	(*AuthorListResponse)(nil),    // 95: book_service.AuthorListResponse
 }
 var file_book_service_proto_depIdxs = []int32{
-	0, // 0: book_service.BookService.Create:input_type -> book_service.CreateBook
-	1, // 1: book_service.BookService.GetByID:input_type -> book_service.BookPK
-	2, // 2: book_service.BookService.GetList:input_type -> book_service.BookListRequest
-	3, // 3: book_service.BookService.Update:input_type -> book_service.UpdateBook
-	4, // 4: book_service.BookService.UpdatePatch:input_type -> book_service.UpdatePatchBook
-	1, // 5: book_service.BookService.Delete:input_type -> book_service.BookPK
-	5, // 6: book_service.BookService.GetBookByTitle:input_type -> book_service.BookByTitle
-	6, // 7: book_service.BookService.Create:output_type -> book_service.OneBookResponse
-	7, // 8: book_service.BookService.GetByID:output_type -> book_service.Book
-	8, // 9: book_service.BookService.GetList:output_type -> book_service.BookResponse
-	7, // 10: book_service.BookService.Update:output_type -> book_service.Book
-	6, // 11: book_service.BookService.UpdatePatch:output_type -> book_service.OneBookResponse
-	8, // 12: book_service.BookService.Delete:output_type -> book_service.BookResponse
-	9, // 13: book_service.BookService.GetBookByTitle:output_type -> book_service.BookResponseByItem
-	7, // [7:14] is the sub-list for method output_type
-	0, // [0:7] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: book_service.BookService.Create:input_type -> book_service.CreateBook
+	1,  // 1: book_service.BookService.GetByID:input_type -> book_service.BookPK
+	2,  // 2: book_service.BookService.GetList:input_type -> book_service.BookListRequest
+	3,  // 3: book_service.BookService.Update:input_type -> book_service.UpdateBook
+	4,  // 4: book_service.BookService.UpdatePatch:input_type -> book_service.UpdatePatchBook
+	5,  // 5: book_service.BookService.Delete:input_type -> book_service.DeleteBookRequest
+	6,  // 6: book_service.BookService.GetBookByTitle:input_type -> book_service.BookByTitle
+	1,  // 7: book_service.BookService.GetStatusHistory:input_type -> book_service.BookPK
+	7,  // 8: book_service.BookService.UpdateProgress:input_type -> book_service.UpdateProgressRequest
+	8,  // 9: book_service.BookService.SetOwnership:input_type -> book_service.SetOwnershipRequest
+	9,  // 10: book_service.BookService.MoveToOwned:input_type -> book_service.MoveToOwnedRequest
+	10, // 11: book_service.BookService.ListDueSoon:input_type -> book_service.DueSoonRequest
+	11, // 12: book_service.BookService.StartSession:input_type -> book_service.StartSessionRequest
+	12, // 13: book_service.BookService.EndSession:input_type -> book_service.EndSessionRequest
+	13, // 14: book_service.BookService.ListSessions:input_type -> book_service.SessionListRequest
+	14, // 15: book_service.BookService.CreateReview:input_type -> book_service.CreateReviewRequest
+	15, // 16: book_service.BookService.UpdateReview:input_type -> book_service.UpdateReviewRequest
+	16, // 17: book_service.BookService.DeleteReview:input_type -> book_service.ReviewPK
+	17, // 18: book_service.BookService.ListReviews:input_type -> book_service.ReviewListRequest
+	18, // 19: book_service.BookService.CreateTag:input_type -> book_service.CreateTagRequest
+	19, // 20: book_service.BookService.RenameTag:input_type -> book_service.RenameTagRequest
+	20, // 21: book_service.BookService.MergeTags:input_type -> book_service.MergeTagsRequest
+	21, // 22: book_service.BookService.DeleteTag:input_type -> book_service.TagPK
+	22, // 23: book_service.BookService.ListTags:input_type -> book_service.TagListRequest
+	23, // 24: book_service.BookService.SetBookTags:input_type -> book_service.SetBookTagsRequest
+	24, // 25: book_service.BookService.CreateShelf:input_type -> book_service.CreateShelfRequest
+	25, // 26: book_service.BookService.GetShelf:input_type -> book_service.ShelfPK
+	26, // 27: book_service.BookService.ListShelves:input_type -> book_service.ShelfListRequest
+	27, // 28: book_service.BookService.UpdateShelf:input_type -> book_service.UpdateShelfRequest
+	25, // 29: book_service.BookService.DeleteShelf:input_type -> book_service.ShelfPK
+	28, // 30: book_service.BookService.AddBookToShelf:input_type -> book_service.ShelfBookRequest
+	28, // 31: book_service.BookService.RemoveBookFromShelf:input_type -> book_service.ShelfBookRequest
+	29, // 32: book_service.BookService.MoveBookOnShelf:input_type -> book_service.MoveShelfBookRequest
+	30, // 33: book_service.BookService.ListShelfBooks:input_type -> book_service.ShelfBooksRequest
+	31, // 34: book_service.BookService.SetGoal:input_type -> book_service.SetGoalRequest
+	32, // 35: book_service.BookService.ListGoals:input_type -> book_service.GoalListRequest
+	33, // 36: book_service.BookService.DeleteGoal:input_type -> book_service.GoalPK
+	33, // 37: book_service.BookService.GetGoalProgress:input_type -> book_service.GoalPK
+	34, // 38: book_service.BookService.GetStats:input_type -> book_service.StatsRequest
+	35, // 39: book_service.BookService.CreateNote:input_type -> book_service.CreateNoteRequest
+	36, // 40: book_service.BookService.GetNote:input_type -> book_service.NotePK
+	37, // 41: book_service.BookService.UpdateNote:input_type -> book_service.UpdateNoteRequest
+	36, // 42: book_service.BookService.DeleteNote:input_type -> book_service.NotePK
+	38, // 43: book_service.BookService.ListBookNotes:input_type -> book_service.BookNotesRequest
+	39, // 44: book_service.BookService.ListNotes:input_type -> book_service.NoteListRequest
+	40, // 45: book_service.BookService.LendBook:input_type -> book_service.LendBookRequest
+	41, // 46: book_service.BookService.ReturnBook:input_type -> book_service.ReturnBookRequest
+	42, // 47: book_service.BookService.ListLoans:input_type -> book_service.LoanListRequest
+	43, // 48: book_service.BookService.CreateSeries:input_type -> book_service.CreateSeriesRequest
+	44, // 49: book_service.BookService.UpdateSeries:input_type -> book_service.UpdateSeriesRequest
+	45, // 50: book_service.BookService.DeleteSeries:input_type -> book_service.SeriesPK
+	46, // 51: book_service.BookService.ListSeries:input_type -> book_service.SeriesListRequest
+	47, // 52: book_service.BookService.SetBookSeries:input_type -> book_service.SetBookSeriesRequest
+	45, // 53: book_service.BookService.NextInSeries:input_type -> book_service.SeriesPK
+	48, // 54: book_service.BookService.GetWork:input_type -> book_service.WorkPK
+	49, // 55: book_service.BookService.ListEditions:input_type -> book_service.EditionListRequest
+	50, // 56: book_service.BookService.SetWorkStatus:input_type -> book_service.SetWorkStatusRequest
+	51, // 57: book_service.BookService.CreateLocation:input_type -> book_service.CreateLocationRequest
+	52, // 58: book_service.BookService.UpdateLocation:input_type -> book_service.UpdateLocationRequest
+	53, // 59: book_service.BookService.DeleteLocation:input_type -> book_service.LocationPK
+	54, // 60: book_service.BookService.ListLocations:input_type -> book_service.LocationListRequest
+	55, // 61: book_service.BookService.AddCopy:input_type -> book_service.CreateCopyRequest
+	56, // 62: book_service.BookService.UpdateCopy:input_type -> book_service.UpdateCopyRequest
+	57, // 63: book_service.BookService.DeleteCopy:input_type -> book_service.CopyPK
+	58, // 64: book_service.BookService.ListCopies:input_type -> book_service.CopyListRequest
+	59, // 65: book_service.BookService.MoveCopy:input_type -> book_service.MoveCopyRequest
+	1,  // 66: book_service.BookService.StartReread:input_type -> book_service.BookPK
+	1,  // 67: book_service.BookService.AbandonRead:input_type -> book_service.BookPK
+	60, // 68: book_service.BookService.ListReads:input_type -> book_service.ReadListRequest
+	61, // 69: book_service.BookService.GetAuthorList:input_type -> book_service.AuthorListRequest
+	62, // 70: book_service.BookService.Create:output_type -> book_service.OneBookResponse
+	63, // 71: book_service.BookService.GetByID:output_type -> book_service.Book
+	64, // 72: book_service.BookService.GetList:output_type -> book_service.BookResponse
+	63, // 73: book_service.BookService.Update:output_type -> book_service.Book
+	62, // 74: book_service.BookService.UpdatePatch:output_type -> book_service.OneBookResponse
+	64, // 75: book_service.BookService.Delete:output_type -> book_service.BookResponse
+	65, // 76: book_service.BookService.GetBookByTitle:output_type -> book_service.BookResponseByItem
+	66, // 77: book_service.BookService.GetStatusHistory:output_type -> book_service.StatusHistoryResponse
+	62, // 78: book_service.BookService.UpdateProgress:output_type -> book_service.OneBookResponse
+	63, // 79: book_service.BookService.SetOwnership:output_type -> book_service.Book
+	63, // 80: book_service.BookService.MoveToOwned:output_type -> book_service.Book
+	67, // 81: book_service.BookService.ListDueSoon:output_type -> book_service.BookListResponse
+	68, // 82: book_service.BookService.StartSession:output_type -> book_service.ReadingSession
+	68, // 83: book_service.BookService.EndSession:output_type -> book_service.ReadingSession
+	69, // 84: book_service.BookService.ListSessions:output_type -> book_service.SessionListResponse
+	70, // 85: book_service.BookService.CreateReview:output_type -> book_service.Review
+	70, // 86: book_service.BookService.UpdateReview:output_type -> book_service.Review
+	71, // 87: book_service.BookService.DeleteReview:output_type -> google.protobuf.Empty
+	72, // 88: book_service.BookService.ListReviews:output_type -> book_service.ReviewListResponse
+	73, // 89: book_service.BookService.CreateTag:output_type -> book_service.Tag
+	73, // 90: book_service.BookService.RenameTag:output_type -> book_service.Tag
+	73, // 91: book_service.BookService.MergeTags:output_type -> book_service.Tag
+	71, // 92: book_service.BookService.DeleteTag:output_type -> google.protobuf.Empty
+	74, // 93: book_service.BookService.ListTags:output_type -> book_service.TagListResponse
+	63, // 94: book_service.BookService.SetBookTags:output_type -> book_service.Book
+	75, // 95: book_service.BookService.CreateShelf:output_type -> book_service.Shelf
+	75, // 96: book_service.BookService.GetShelf:output_type -> book_service.Shelf
+	76, // 97: book_service.BookService.ListShelves:output_type -> book_service.ShelfListResponse
+	75, // 98: book_service.BookService.UpdateShelf:output_type -> book_service.Shelf
+	71, // 99: book_service.BookService.DeleteShelf:output_type -> google.protobuf.Empty
+	71, // 100: book_service.BookService.AddBookToShelf:output_type -> google.protobuf.Empty
+	71, // 101: book_service.BookService.RemoveBookFromShelf:output_type -> google.protobuf.Empty
+	71, // 102: book_service.BookService.MoveBookOnShelf:output_type -> google.protobuf.Empty
+	77, // 103: book_service.BookService.ListShelfBooks:output_type -> book_service.ShelfBooksResponse
+	78, // 104: book_service.BookService.SetGoal:output_type -> book_service.Goal
+	79, // 105: book_service.BookService.ListGoals:output_type -> book_service.GoalListResponse
+	71, // 106: book_service.BookService.DeleteGoal:output_type -> google.protobuf.Empty
+	80, // 107: book_service.BookService.GetGoalProgress:output_type -> book_service.GoalProgress
+	81, // 108: book_service.BookService.GetStats:output_type -> book_service.Stats
+	82, // 109: book_service.BookService.CreateNote:output_type -> book_service.Note
+	82, // 110: book_service.BookService.GetNote:output_type -> book_service.Note
+	82, // 111: book_service.BookService.UpdateNote:output_type -> book_service.Note
+	71, // 112: book_service.BookService.DeleteNote:output_type -> google.protobuf.Empty
+	83, // 113: book_service.BookService.ListBookNotes:output_type -> book_service.NoteListResponse
+	83, // 114: book_service.BookService.ListNotes:output_type -> book_service.NoteListResponse
+	84, // 115: book_service.BookService.LendBook:output_type -> book_service.Loan
+	84, // 116: book_service.BookService.ReturnBook:output_type -> book_service.Loan
+	85, // 117: book_service.BookService.ListLoans:output_type -> book_service.LoanListResponse
+	86, // 118: book_service.BookService.CreateSeries:output_type -> book_service.Series
+	86, // 119: book_service.BookService.UpdateSeries:output_type -> book_service.Series
+	71, // 120: book_service.BookService.DeleteSeries:output_type -> google.protobuf.Empty
+	87, // 121: book_service.BookService.ListSeries:output_type -> book_service.SeriesListResponse
+	63, // 122: book_service.BookService.SetBookSeries:output_type -> book_service.Book
+	63, // 123: book_service.BookService.NextInSeries:output_type -> book_service.Book
+	88, // 124: book_service.BookService.GetWork:output_type -> book_service.Work
+	67, // 125: book_service.BookService.ListEditions:output_type -> book_service.BookListResponse
+	88, // 126: book_service.BookService.SetWorkStatus:output_type -> book_service.Work
+	89, // 127: book_service.BookService.CreateLocation:output_type -> book_service.Location
+	89, // 128: book_service.BookService.UpdateLocation:output_type -> book_service.Location
+	71, // 129: book_service.BookService.DeleteLocation:output_type -> google.protobuf.Empty
+	90, // 130: book_service.BookService.ListLocations:output_type -> book_service.LocationListResponse
+	91, // 131: book_service.BookService.AddCopy:output_type -> book_service.Copy
+	91, // 132: book_service.BookService.UpdateCopy:output_type -> book_service.Copy
+	71, // 133: book_service.BookService.DeleteCopy:output_type -> google.protobuf.Empty
+	92, // 134: book_service.BookService.ListCopies:output_type -> book_service.CopyListResponse
+	91, // 135: book_service.BookService.MoveCopy:output_type -> book_service.Copy
+	93, // 136: book_service.BookService.StartReread:output_type -> book_service.Read
+	93, // 137: book_service.BookService.AbandonRead:output_type -> book_service.Read
+	94, // 138: book_service.BookService.ListReads:output_type -> book_service.ReadListResponse
+	95, // 139: book_service.BookService.GetAuthorList:output_type -> book_service.AuthorListResponse
+	70, // [70:140] is the sub-list for method output_type
+	0,  // [0:70] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_book_service_proto_init() }
@@ -103,6 +611,18 @@ func file_book_service_proto_init() {
 		return
 	}
 	file_book_proto_init()
+	file_session_proto_init()
+	file_review_proto_init()
+	file_tag_proto_init()
+	file_shelf_proto_init()
+	file_goal_proto_init()
+	file_stats_proto_init()
+	file_note_proto_init()
+	file_loan_proto_init()
+	file_series_proto_init()
+	file_work_proto_init()
+	file_copy_proto_init()
+	file_read_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
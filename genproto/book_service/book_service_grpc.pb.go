@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,13 +20,76 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	BookService_Create_FullMethodName         = "/book_service.BookService/Create"
-	BookService_GetByID_FullMethodName        = "/book_service.BookService/GetByID"
-	BookService_GetList_FullMethodName        = "/book_service.BookService/GetList"
-	BookService_Update_FullMethodName         = "/book_service.BookService/Update"
-	BookService_UpdatePatch_FullMethodName    = "/book_service.BookService/UpdatePatch"
-	BookService_Delete_FullMethodName         = "/book_service.BookService/Delete"
-	BookService_GetBookByTitle_FullMethodName = "/book_service.BookService/GetBookByTitle"
+	BookService_Create_FullMethodName              = "/book_service.BookService/Create"
+	BookService_GetByID_FullMethodName             = "/book_service.BookService/GetByID"
+	BookService_GetList_FullMethodName             = "/book_service.BookService/GetList"
+	BookService_Update_FullMethodName              = "/book_service.BookService/Update"
+	BookService_UpdatePatch_FullMethodName         = "/book_service.BookService/UpdatePatch"
+	BookService_Delete_FullMethodName              = "/book_service.BookService/Delete"
+	BookService_GetBookByTitle_FullMethodName      = "/book_service.BookService/GetBookByTitle"
+	BookService_GetStatusHistory_FullMethodName    = "/book_service.BookService/GetStatusHistory"
+	BookService_UpdateProgress_FullMethodName      = "/book_service.BookService/UpdateProgress"
+	BookService_SetOwnership_FullMethodName        = "/book_service.BookService/SetOwnership"
+	BookService_MoveToOwned_FullMethodName         = "/book_service.BookService/MoveToOwned"
+	BookService_ListDueSoon_FullMethodName         = "/book_service.BookService/ListDueSoon"
+	BookService_StartSession_FullMethodName        = "/book_service.BookService/StartSession"
+	BookService_EndSession_FullMethodName          = "/book_service.BookService/EndSession"
+	BookService_ListSessions_FullMethodName        = "/book_service.BookService/ListSessions"
+	BookService_CreateReview_FullMethodName        = "/book_service.BookService/CreateReview"
+	BookService_UpdateReview_FullMethodName        = "/book_service.BookService/UpdateReview"
+	BookService_DeleteReview_FullMethodName        = "/book_service.BookService/DeleteReview"
+	BookService_ListReviews_FullMethodName         = "/book_service.BookService/ListReviews"
+	BookService_CreateTag_FullMethodName           = "/book_service.BookService/CreateTag"
+	BookService_RenameTag_FullMethodName           = "/book_service.BookService/RenameTag"
+	BookService_MergeTags_FullMethodName           = "/book_service.BookService/MergeTags"
+	BookService_DeleteTag_FullMethodName           = "/book_service.BookService/DeleteTag"
+	BookService_ListTags_FullMethodName            = "/book_service.BookService/ListTags"
+	BookService_SetBookTags_FullMethodName         = "/book_service.BookService/SetBookTags"
+	BookService_CreateShelf_FullMethodName         = "/book_service.BookService/CreateShelf"
+	BookService_GetShelf_FullMethodName            = "/book_service.BookService/GetShelf"
+	BookService_ListShelves_FullMethodName         = "/book_service.BookService/ListShelves"
+	BookService_UpdateShelf_FullMethodName         = "/book_service.BookService/UpdateShelf"
+	BookService_DeleteShelf_FullMethodName         = "/book_service.BookService/DeleteShelf"
+	BookService_AddBookToShelf_FullMethodName      = "/book_service.BookService/AddBookToShelf"
+	BookService_RemoveBookFromShelf_FullMethodName = "/book_service.BookService/RemoveBookFromShelf"
+	BookService_MoveBookOnShelf_FullMethodName     = "/book_service.BookService/MoveBookOnShelf"
+	BookService_ListShelfBooks_FullMethodName      = "/book_service.BookService/ListShelfBooks"
+	BookService_SetGoal_FullMethodName             = "/book_service.BookService/SetGoal"
+	BookService_ListGoals_FullMethodName           = "/book_service.BookService/ListGoals"
+	BookService_DeleteGoal_FullMethodName          = "/book_service.BookService/DeleteGoal"
+	BookService_GetGoalProgress_FullMethodName     = "/book_service.BookService/GetGoalProgress"
+	BookService_GetStats_FullMethodName            = "/book_service.BookService/GetStats"
+	BookService_CreateNote_FullMethodName          = "/book_service.BookService/CreateNote"
+	BookService_GetNote_FullMethodName             = "/book_service.BookService/GetNote"
+	BookService_UpdateNote_FullMethodName          = "/book_service.BookService/UpdateNote"
+	BookService_DeleteNote_FullMethodName          = "/book_service.BookService/DeleteNote"
+	BookService_ListBookNotes_FullMethodName       = "/book_service.BookService/ListBookNotes"
+	BookService_ListNotes_FullMethodName           = "/book_service.BookService/ListNotes"
+	BookService_LendBook_FullMethodName            = "/book_service.BookService/LendBook"
+	BookService_ReturnBook_FullMethodName          = "/book_service.BookService/ReturnBook"
+	BookService_ListLoans_FullMethodName           = "/book_service.BookService/ListLoans"
+	BookService_CreateSeries_FullMethodName        = "/book_service.BookService/CreateSeries"
+	BookService_UpdateSeries_FullMethodName        = "/book_service.BookService/UpdateSeries"
+	BookService_DeleteSeries_FullMethodName        = "/book_service.BookService/DeleteSeries"
+	BookService_ListSeries_FullMethodName          = "/book_service.BookService/ListSeries"
+	BookService_SetBookSeries_FullMethodName       = "/book_service.BookService/SetBookSeries"
+	BookService_NextInSeries_FullMethodName        = "/book_service.BookService/NextInSeries"
+	BookService_GetWork_FullMethodName             = "/book_service.BookService/GetWork"
+	BookService_ListEditions_FullMethodName        = "/book_service.BookService/ListEditions"
+	BookService_SetWorkStatus_FullMethodName       = "/book_service.BookService/SetWorkStatus"
+	BookService_CreateLocation_FullMethodName      = "/book_service.BookService/CreateLocation"
+	BookService_UpdateLocation_FullMethodName      = "/book_service.BookService/UpdateLocation"
+	BookService_DeleteLocation_FullMethodName      = "/book_service.BookService/DeleteLocation"
+	BookService_ListLocations_FullMethodName       = "/book_service.BookService/ListLocations"
+	BookService_AddCopy_FullMethodName             = "/book_service.BookService/AddCopy"
+	BookService_UpdateCopy_FullMethodName          = "/book_service.BookService/UpdateCopy"
+	BookService_DeleteCopy_FullMethodName          = "/book_service.BookService/DeleteCopy"
+	BookService_ListCopies_FullMethodName          = "/book_service.BookService/ListCopies"
+	BookService_MoveCopy_FullMethodName            = "/book_service.BookService/MoveCopy"
+	BookService_StartReread_FullMethodName         = "/book_service.BookService/StartReread"
+	BookService_AbandonRead_FullMethodName         = "/book_service.BookService/AbandonRead"
+	BookService_ListReads_FullMethodName           = "/book_service.BookService/ListReads"
+	BookService_GetAuthorList_FullMethodName       = "/book_service.BookService/GetAuthorList"
 )
 
 // BookServiceClient is the client API for BookService service.
@@ -37,8 +101,71 @@ type BookServiceClient interface {
 	GetList(ctx context.Context, in *BookListRequest, opts ...grpc.CallOption) (*BookResponse, error)
 	Update(ctx context.Context, in *UpdateBook, opts ...grpc.CallOption) (*Book, error)
 	UpdatePatch(ctx context.Context, in *UpdatePatchBook, opts ...grpc.CallOption) (*OneBookResponse, error)
-	Delete(ctx context.Context, in *BookPK, opts ...grpc.CallOption) (*BookResponse, error)
+	Delete(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*BookResponse, error)
 	GetBookByTitle(ctx context.Context, in *BookByTitle, opts ...grpc.CallOption) (*BookResponseByItem, error)
+	GetStatusHistory(ctx context.Context, in *BookPK, opts ...grpc.CallOption) (*StatusHistoryResponse, error)
+	UpdateProgress(ctx context.Context, in *UpdateProgressRequest, opts ...grpc.CallOption) (*OneBookResponse, error)
+	SetOwnership(ctx context.Context, in *SetOwnershipRequest, opts ...grpc.CallOption) (*Book, error)
+	MoveToOwned(ctx context.Context, in *MoveToOwnedRequest, opts ...grpc.CallOption) (*Book, error)
+	ListDueSoon(ctx context.Context, in *DueSoonRequest, opts ...grpc.CallOption) (*BookListResponse, error)
+	StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*ReadingSession, error)
+	EndSession(ctx context.Context, in *EndSessionRequest, opts ...grpc.CallOption) (*ReadingSession, error)
+	ListSessions(ctx context.Context, in *SessionListRequest, opts ...grpc.CallOption) (*SessionListResponse, error)
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	DeleteReview(ctx context.Context, in *ReviewPK, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListReviews(ctx context.Context, in *ReviewListRequest, opts ...grpc.CallOption) (*ReviewListResponse, error)
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*Tag, error)
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*Tag, error)
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*Tag, error)
+	DeleteTag(ctx context.Context, in *TagPK, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListTags(ctx context.Context, in *TagListRequest, opts ...grpc.CallOption) (*TagListResponse, error)
+	SetBookTags(ctx context.Context, in *SetBookTagsRequest, opts ...grpc.CallOption) (*Book, error)
+	CreateShelf(ctx context.Context, in *CreateShelfRequest, opts ...grpc.CallOption) (*Shelf, error)
+	GetShelf(ctx context.Context, in *ShelfPK, opts ...grpc.CallOption) (*Shelf, error)
+	ListShelves(ctx context.Context, in *ShelfListRequest, opts ...grpc.CallOption) (*ShelfListResponse, error)
+	UpdateShelf(ctx context.Context, in *UpdateShelfRequest, opts ...grpc.CallOption) (*Shelf, error)
+	DeleteShelf(ctx context.Context, in *ShelfPK, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddBookToShelf(ctx context.Context, in *ShelfBookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveBookFromShelf(ctx context.Context, in *ShelfBookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MoveBookOnShelf(ctx context.Context, in *MoveShelfBookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListShelfBooks(ctx context.Context, in *ShelfBooksRequest, opts ...grpc.CallOption) (*ShelfBooksResponse, error)
+	SetGoal(ctx context.Context, in *SetGoalRequest, opts ...grpc.CallOption) (*Goal, error)
+	ListGoals(ctx context.Context, in *GoalListRequest, opts ...grpc.CallOption) (*GoalListResponse, error)
+	DeleteGoal(ctx context.Context, in *GoalPK, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetGoalProgress(ctx context.Context, in *GoalPK, opts ...grpc.CallOption) (*GoalProgress, error)
+	GetStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*Stats, error)
+	CreateNote(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*Note, error)
+	GetNote(ctx context.Context, in *NotePK, opts ...grpc.CallOption) (*Note, error)
+	UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*Note, error)
+	DeleteNote(ctx context.Context, in *NotePK, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListBookNotes(ctx context.Context, in *BookNotesRequest, opts ...grpc.CallOption) (*NoteListResponse, error)
+	ListNotes(ctx context.Context, in *NoteListRequest, opts ...grpc.CallOption) (*NoteListResponse, error)
+	LendBook(ctx context.Context, in *LendBookRequest, opts ...grpc.CallOption) (*Loan, error)
+	ReturnBook(ctx context.Context, in *ReturnBookRequest, opts ...grpc.CallOption) (*Loan, error)
+	ListLoans(ctx context.Context, in *LoanListRequest, opts ...grpc.CallOption) (*LoanListResponse, error)
+	CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*Series, error)
+	UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*Series, error)
+	DeleteSeries(ctx context.Context, in *SeriesPK, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListSeries(ctx context.Context, in *SeriesListRequest, opts ...grpc.CallOption) (*SeriesListResponse, error)
+	SetBookSeries(ctx context.Context, in *SetBookSeriesRequest, opts ...grpc.CallOption) (*Book, error)
+	NextInSeries(ctx context.Context, in *SeriesPK, opts ...grpc.CallOption) (*Book, error)
+	GetWork(ctx context.Context, in *WorkPK, opts ...grpc.CallOption) (*Work, error)
+	ListEditions(ctx context.Context, in *EditionListRequest, opts ...grpc.CallOption) (*BookListResponse, error)
+	SetWorkStatus(ctx context.Context, in *SetWorkStatusRequest, opts ...grpc.CallOption) (*Work, error)
+	CreateLocation(ctx context.Context, in *CreateLocationRequest, opts ...grpc.CallOption) (*Location, error)
+	UpdateLocation(ctx context.Context, in *UpdateLocationRequest, opts ...grpc.CallOption) (*Location, error)
+	DeleteLocation(ctx context.Context, in *LocationPK, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListLocations(ctx context.Context, in *LocationListRequest, opts ...grpc.CallOption) (*LocationListResponse, error)
+	AddCopy(ctx context.Context, in *CreateCopyRequest, opts ...grpc.CallOption) (*Copy, error)
+	UpdateCopy(ctx context.Context, in *UpdateCopyRequest, opts ...grpc.CallOption) (*Copy, error)
+	DeleteCopy(ctx context.Context, in *CopyPK, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListCopies(ctx context.Context, in *CopyListRequest, opts ...grpc.CallOption) (*CopyListResponse, error)
+	MoveCopy(ctx context.Context, in *MoveCopyRequest, opts ...grpc.CallOption) (*Copy, error)
+	StartReread(ctx context.Context, in *BookPK, opts ...grpc.CallOption) (*Read, error)
+	AbandonRead(ctx context.Context, in *BookPK, opts ...grpc.CallOption) (*Read, error)
+	ListReads(ctx context.Context, in *ReadListRequest, opts ...grpc.CallOption) (*ReadListResponse, error)
+	GetAuthorList(ctx context.Context, in *AuthorListRequest, opts ...grpc.CallOption) (*AuthorListResponse, error)
 }
 
 type bookServiceClient struct {
@@ -94,7 +221,7 @@ func (c *bookServiceClient) UpdatePatch(ctx context.Context, in *UpdatePatchBook
 	return out, nil
 }
 
-func (c *bookServiceClient) Delete(ctx context.Context, in *BookPK, opts ...grpc.CallOption) (*BookResponse, error) {
+func (c *bookServiceClient) Delete(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*BookResponse, error) {
 	out := new(BookResponse)
 	err := c.cc.Invoke(ctx, BookService_Delete_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -112,6 +239,573 @@ func (c *bookServiceClient) GetBookByTitle(ctx context.Context, in *BookByTitle,
 	return out, nil
 }
 
+func (c *bookServiceClient) GetStatusHistory(ctx context.Context, in *BookPK, opts ...grpc.CallOption) (*StatusHistoryResponse, error) {
+	out := new(StatusHistoryResponse)
+	err := c.cc.Invoke(ctx, BookService_GetStatusHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateProgress(ctx context.Context, in *UpdateProgressRequest, opts ...grpc.CallOption) (*OneBookResponse, error) {
+	out := new(OneBookResponse)
+	err := c.cc.Invoke(ctx, BookService_UpdateProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) SetOwnership(ctx context.Context, in *SetOwnershipRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BookService_SetOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) MoveToOwned(ctx context.Context, in *MoveToOwnedRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BookService_MoveToOwned_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListDueSoon(ctx context.Context, in *DueSoonRequest, opts ...grpc.CallOption) (*BookListResponse, error) {
+	out := new(BookListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListDueSoon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*ReadingSession, error) {
+	out := new(ReadingSession)
+	err := c.cc.Invoke(ctx, BookService_StartSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) EndSession(ctx context.Context, in *EndSessionRequest, opts ...grpc.CallOption) (*ReadingSession, error) {
+	out := new(ReadingSession)
+	err := c.cc.Invoke(ctx, BookService_EndSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListSessions(ctx context.Context, in *SessionListRequest, opts ...grpc.CallOption) (*SessionListResponse, error) {
+	out := new(SessionListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	out := new(Review)
+	err := c.cc.Invoke(ctx, BookService_CreateReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	out := new(Review)
+	err := c.cc.Invoke(ctx, BookService_UpdateReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteReview(ctx context.Context, in *ReviewPK, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BookService_DeleteReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListReviews(ctx context.Context, in *ReviewListRequest, opts ...grpc.CallOption) (*ReviewListResponse, error) {
+	out := new(ReviewListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*Tag, error) {
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, BookService_CreateTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*Tag, error) {
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, BookService_RenameTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*Tag, error) {
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, BookService_MergeTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteTag(ctx context.Context, in *TagPK, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BookService_DeleteTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListTags(ctx context.Context, in *TagListRequest, opts ...grpc.CallOption) (*TagListResponse, error) {
+	out := new(TagListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) SetBookTags(ctx context.Context, in *SetBookTagsRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BookService_SetBookTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) CreateShelf(ctx context.Context, in *CreateShelfRequest, opts ...grpc.CallOption) (*Shelf, error) {
+	out := new(Shelf)
+	err := c.cc.Invoke(ctx, BookService_CreateShelf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetShelf(ctx context.Context, in *ShelfPK, opts ...grpc.CallOption) (*Shelf, error) {
+	out := new(Shelf)
+	err := c.cc.Invoke(ctx, BookService_GetShelf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListShelves(ctx context.Context, in *ShelfListRequest, opts ...grpc.CallOption) (*ShelfListResponse, error) {
+	out := new(ShelfListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListShelves_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateShelf(ctx context.Context, in *UpdateShelfRequest, opts ...grpc.CallOption) (*Shelf, error) {
+	out := new(Shelf)
+	err := c.cc.Invoke(ctx, BookService_UpdateShelf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteShelf(ctx context.Context, in *ShelfPK, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BookService_DeleteShelf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) AddBookToShelf(ctx context.Context, in *ShelfBookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BookService_AddBookToShelf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) RemoveBookFromShelf(ctx context.Context, in *ShelfBookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BookService_RemoveBookFromShelf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) MoveBookOnShelf(ctx context.Context, in *MoveShelfBookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BookService_MoveBookOnShelf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListShelfBooks(ctx context.Context, in *ShelfBooksRequest, opts ...grpc.CallOption) (*ShelfBooksResponse, error) {
+	out := new(ShelfBooksResponse)
+	err := c.cc.Invoke(ctx, BookService_ListShelfBooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) SetGoal(ctx context.Context, in *SetGoalRequest, opts ...grpc.CallOption) (*Goal, error) {
+	out := new(Goal)
+	err := c.cc.Invoke(ctx, BookService_SetGoal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListGoals(ctx context.Context, in *GoalListRequest, opts ...grpc.CallOption) (*GoalListResponse, error) {
+	out := new(GoalListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListGoals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteGoal(ctx context.Context, in *GoalPK, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BookService_DeleteGoal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetGoalProgress(ctx context.Context, in *GoalPK, opts ...grpc.CallOption) (*GoalProgress, error) {
+	out := new(GoalProgress)
+	err := c.cc.Invoke(ctx, BookService_GetGoalProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*Stats, error) {
+	out := new(Stats)
+	err := c.cc.Invoke(ctx, BookService_GetStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) CreateNote(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*Note, error) {
+	out := new(Note)
+	err := c.cc.Invoke(ctx, BookService_CreateNote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetNote(ctx context.Context, in *NotePK, opts ...grpc.CallOption) (*Note, error) {
+	out := new(Note)
+	err := c.cc.Invoke(ctx, BookService_GetNote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*Note, error) {
+	out := new(Note)
+	err := c.cc.Invoke(ctx, BookService_UpdateNote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteNote(ctx context.Context, in *NotePK, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BookService_DeleteNote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListBookNotes(ctx context.Context, in *BookNotesRequest, opts ...grpc.CallOption) (*NoteListResponse, error) {
+	out := new(NoteListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListBookNotes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListNotes(ctx context.Context, in *NoteListRequest, opts ...grpc.CallOption) (*NoteListResponse, error) {
+	out := new(NoteListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListNotes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) LendBook(ctx context.Context, in *LendBookRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, BookService_LendBook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ReturnBook(ctx context.Context, in *ReturnBookRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, BookService_ReturnBook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListLoans(ctx context.Context, in *LoanListRequest, opts ...grpc.CallOption) (*LoanListResponse, error) {
+	out := new(LoanListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListLoans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*Series, error) {
+	out := new(Series)
+	err := c.cc.Invoke(ctx, BookService_CreateSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*Series, error) {
+	out := new(Series)
+	err := c.cc.Invoke(ctx, BookService_UpdateSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteSeries(ctx context.Context, in *SeriesPK, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BookService_DeleteSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListSeries(ctx context.Context, in *SeriesListRequest, opts ...grpc.CallOption) (*SeriesListResponse, error) {
+	out := new(SeriesListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) SetBookSeries(ctx context.Context, in *SetBookSeriesRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BookService_SetBookSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) NextInSeries(ctx context.Context, in *SeriesPK, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BookService_NextInSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetWork(ctx context.Context, in *WorkPK, opts ...grpc.CallOption) (*Work, error) {
+	out := new(Work)
+	err := c.cc.Invoke(ctx, BookService_GetWork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListEditions(ctx context.Context, in *EditionListRequest, opts ...grpc.CallOption) (*BookListResponse, error) {
+	out := new(BookListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListEditions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) SetWorkStatus(ctx context.Context, in *SetWorkStatusRequest, opts ...grpc.CallOption) (*Work, error) {
+	out := new(Work)
+	err := c.cc.Invoke(ctx, BookService_SetWorkStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) CreateLocation(ctx context.Context, in *CreateLocationRequest, opts ...grpc.CallOption) (*Location, error) {
+	out := new(Location)
+	err := c.cc.Invoke(ctx, BookService_CreateLocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateLocation(ctx context.Context, in *UpdateLocationRequest, opts ...grpc.CallOption) (*Location, error) {
+	out := new(Location)
+	err := c.cc.Invoke(ctx, BookService_UpdateLocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteLocation(ctx context.Context, in *LocationPK, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BookService_DeleteLocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListLocations(ctx context.Context, in *LocationListRequest, opts ...grpc.CallOption) (*LocationListResponse, error) {
+	out := new(LocationListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListLocations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) AddCopy(ctx context.Context, in *CreateCopyRequest, opts ...grpc.CallOption) (*Copy, error) {
+	out := new(Copy)
+	err := c.cc.Invoke(ctx, BookService_AddCopy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateCopy(ctx context.Context, in *UpdateCopyRequest, opts ...grpc.CallOption) (*Copy, error) {
+	out := new(Copy)
+	err := c.cc.Invoke(ctx, BookService_UpdateCopy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteCopy(ctx context.Context, in *CopyPK, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BookService_DeleteCopy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListCopies(ctx context.Context, in *CopyListRequest, opts ...grpc.CallOption) (*CopyListResponse, error) {
+	out := new(CopyListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListCopies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) MoveCopy(ctx context.Context, in *MoveCopyRequest, opts ...grpc.CallOption) (*Copy, error) {
+	out := new(Copy)
+	err := c.cc.Invoke(ctx, BookService_MoveCopy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) StartReread(ctx context.Context, in *BookPK, opts ...grpc.CallOption) (*Read, error) {
+	out := new(Read)
+	err := c.cc.Invoke(ctx, BookService_StartReread_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) AbandonRead(ctx context.Context, in *BookPK, opts ...grpc.CallOption) (*Read, error) {
+	out := new(Read)
+	err := c.cc.Invoke(ctx, BookService_AbandonRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListReads(ctx context.Context, in *ReadListRequest, opts ...grpc.CallOption) (*ReadListResponse, error) {
+	out := new(ReadListResponse)
+	err := c.cc.Invoke(ctx, BookService_ListReads_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetAuthorList(ctx context.Context, in *AuthorListRequest, opts ...grpc.CallOption) (*AuthorListResponse, error) {
+	out := new(AuthorListResponse)
+	err := c.cc.Invoke(ctx, BookService_GetAuthorList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookServiceServer is the server API for BookService service.
 // All implementations must embed UnimplementedBookServiceServer
 // for forward compatibility
@@ -121,171 +815,1557 @@ type BookServiceServer interface {
 	GetList(context.Context, *BookListRequest) (*BookResponse, error)
 	Update(context.Context, *UpdateBook) (*Book, error)
 	UpdatePatch(context.Context, *UpdatePatchBook) (*OneBookResponse, error)
-	Delete(context.Context, *BookPK) (*BookResponse, error)
+	Delete(context.Context, *DeleteBookRequest) (*BookResponse, error)
 	GetBookByTitle(context.Context, *BookByTitle) (*BookResponseByItem, error)
+	GetStatusHistory(context.Context, *BookPK) (*StatusHistoryResponse, error)
+	UpdateProgress(context.Context, *UpdateProgressRequest) (*OneBookResponse, error)
+	SetOwnership(context.Context, *SetOwnershipRequest) (*Book, error)
+	MoveToOwned(context.Context, *MoveToOwnedRequest) (*Book, error)
+	ListDueSoon(context.Context, *DueSoonRequest) (*BookListResponse, error)
+	StartSession(context.Context, *StartSessionRequest) (*ReadingSession, error)
+	EndSession(context.Context, *EndSessionRequest) (*ReadingSession, error)
+	ListSessions(context.Context, *SessionListRequest) (*SessionListResponse, error)
+	CreateReview(context.Context, *CreateReviewRequest) (*Review, error)
+	UpdateReview(context.Context, *UpdateReviewRequest) (*Review, error)
+	DeleteReview(context.Context, *ReviewPK) (*emptypb.Empty, error)
+	ListReviews(context.Context, *ReviewListRequest) (*ReviewListResponse, error)
+	CreateTag(context.Context, *CreateTagRequest) (*Tag, error)
+	RenameTag(context.Context, *RenameTagRequest) (*Tag, error)
+	MergeTags(context.Context, *MergeTagsRequest) (*Tag, error)
+	DeleteTag(context.Context, *TagPK) (*emptypb.Empty, error)
+	ListTags(context.Context, *TagListRequest) (*TagListResponse, error)
+	SetBookTags(context.Context, *SetBookTagsRequest) (*Book, error)
+	CreateShelf(context.Context, *CreateShelfRequest) (*Shelf, error)
+	GetShelf(context.Context, *ShelfPK) (*Shelf, error)
+	ListShelves(context.Context, *ShelfListRequest) (*ShelfListResponse, error)
+	UpdateShelf(context.Context, *UpdateShelfRequest) (*Shelf, error)
+	DeleteShelf(context.Context, *ShelfPK) (*emptypb.Empty, error)
+	AddBookToShelf(context.Context, *ShelfBookRequest) (*emptypb.Empty, error)
+	RemoveBookFromShelf(context.Context, *ShelfBookRequest) (*emptypb.Empty, error)
+	MoveBookOnShelf(context.Context, *MoveShelfBookRequest) (*emptypb.Empty, error)
+	ListShelfBooks(context.Context, *ShelfBooksRequest) (*ShelfBooksResponse, error)
+	SetGoal(context.Context, *SetGoalRequest) (*Goal, error)
+	ListGoals(context.Context, *GoalListRequest) (*GoalListResponse, error)
+	DeleteGoal(context.Context, *GoalPK) (*emptypb.Empty, error)
+	GetGoalProgress(context.Context, *GoalPK) (*GoalProgress, error)
+	GetStats(context.Context, *StatsRequest) (*Stats, error)
+	CreateNote(context.Context, *CreateNoteRequest) (*Note, error)
+	GetNote(context.Context, *NotePK) (*Note, error)
+	UpdateNote(context.Context, *UpdateNoteRequest) (*Note, error)
+	DeleteNote(context.Context, *NotePK) (*emptypb.Empty, error)
+	ListBookNotes(context.Context, *BookNotesRequest) (*NoteListResponse, error)
+	ListNotes(context.Context, *NoteListRequest) (*NoteListResponse, error)
+	LendBook(context.Context, *LendBookRequest) (*Loan, error)
+	ReturnBook(context.Context, *ReturnBookRequest) (*Loan, error)
+	ListLoans(context.Context, *LoanListRequest) (*LoanListResponse, error)
+	CreateSeries(context.Context, *CreateSeriesRequest) (*Series, error)
+	UpdateSeries(context.Context, *UpdateSeriesRequest) (*Series, error)
+	DeleteSeries(context.Context, *SeriesPK) (*emptypb.Empty, error)
+	ListSeries(context.Context, *SeriesListRequest) (*SeriesListResponse, error)
+	SetBookSeries(context.Context, *SetBookSeriesRequest) (*Book, error)
+	NextInSeries(context.Context, *SeriesPK) (*Book, error)
+	GetWork(context.Context, *WorkPK) (*Work, error)
+	ListEditions(context.Context, *EditionListRequest) (*BookListResponse, error)
+	SetWorkStatus(context.Context, *SetWorkStatusRequest) (*Work, error)
+	CreateLocation(context.Context, *CreateLocationRequest) (*Location, error)
+	UpdateLocation(context.Context, *UpdateLocationRequest) (*Location, error)
+	DeleteLocation(context.Context, *LocationPK) (*emptypb.Empty, error)
+	ListLocations(context.Context, *LocationListRequest) (*LocationListResponse, error)
+	AddCopy(context.Context, *CreateCopyRequest) (*Copy, error)
+	UpdateCopy(context.Context, *UpdateCopyRequest) (*Copy, error)
+	DeleteCopy(context.Context, *CopyPK) (*emptypb.Empty, error)
+	ListCopies(context.Context, *CopyListRequest) (*CopyListResponse, error)
+	MoveCopy(context.Context, *MoveCopyRequest) (*Copy, error)
+	StartReread(context.Context, *BookPK) (*Read, error)
+	AbandonRead(context.Context, *BookPK) (*Read, error)
+	ListReads(context.Context, *ReadListRequest) (*ReadListResponse, error)
+	GetAuthorList(context.Context, *AuthorListRequest) (*AuthorListResponse, error)
+	mustEmbedUnimplementedBookServiceServer()
+}
+
+// UnimplementedBookServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBookServiceServer struct {
+}
+
+func (UnimplementedBookServiceServer) Create(context.Context, *CreateBook) (*OneBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedBookServiceServer) GetByID(context.Context, *BookPK) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedBookServiceServer) GetList(context.Context, *BookListRequest) (*BookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedBookServiceServer) Update(context.Context, *UpdateBook) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedBookServiceServer) UpdatePatch(context.Context, *UpdatePatchBook) (*OneBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePatch not implemented")
+}
+func (UnimplementedBookServiceServer) Delete(context.Context, *DeleteBookRequest) (*BookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedBookServiceServer) GetBookByTitle(context.Context, *BookByTitle) (*BookResponseByItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookByTitle not implemented")
+}
+func (UnimplementedBookServiceServer) GetStatusHistory(context.Context, *BookPK) (*StatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusHistory not implemented")
+}
+func (UnimplementedBookServiceServer) UpdateProgress(context.Context, *UpdateProgressRequest) (*OneBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProgress not implemented")
+}
+func (UnimplementedBookServiceServer) SetOwnership(context.Context, *SetOwnershipRequest) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOwnership not implemented")
+}
+func (UnimplementedBookServiceServer) MoveToOwned(context.Context, *MoveToOwnedRequest) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToOwned not implemented")
+}
+func (UnimplementedBookServiceServer) ListDueSoon(context.Context, *DueSoonRequest) (*BookListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDueSoon not implemented")
+}
+func (UnimplementedBookServiceServer) StartSession(context.Context, *StartSessionRequest) (*ReadingSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSession not implemented")
+}
+func (UnimplementedBookServiceServer) EndSession(context.Context, *EndSessionRequest) (*ReadingSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndSession not implemented")
+}
+func (UnimplementedBookServiceServer) ListSessions(context.Context, *SessionListRequest) (*SessionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedBookServiceServer) CreateReview(context.Context, *CreateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedBookServiceServer) UpdateReview(context.Context, *UpdateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedBookServiceServer) DeleteReview(context.Context, *ReviewPK) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedBookServiceServer) ListReviews(context.Context, *ReviewListRequest) (*ReviewListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (UnimplementedBookServiceServer) CreateTag(context.Context, *CreateTagRequest) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedBookServiceServer) RenameTag(context.Context, *RenameTagRequest) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedBookServiceServer) MergeTags(context.Context, *MergeTagsRequest) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedBookServiceServer) DeleteTag(context.Context, *TagPK) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedBookServiceServer) ListTags(context.Context, *TagListRequest) (*TagListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedBookServiceServer) SetBookTags(context.Context, *SetBookTagsRequest) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBookTags not implemented")
+}
+func (UnimplementedBookServiceServer) CreateShelf(context.Context, *CreateShelfRequest) (*Shelf, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShelf not implemented")
+}
+func (UnimplementedBookServiceServer) GetShelf(context.Context, *ShelfPK) (*Shelf, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShelf not implemented")
+}
+func (UnimplementedBookServiceServer) ListShelves(context.Context, *ShelfListRequest) (*ShelfListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShelves not implemented")
+}
+func (UnimplementedBookServiceServer) UpdateShelf(context.Context, *UpdateShelfRequest) (*Shelf, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShelf not implemented")
+}
+func (UnimplementedBookServiceServer) DeleteShelf(context.Context, *ShelfPK) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShelf not implemented")
+}
+func (UnimplementedBookServiceServer) AddBookToShelf(context.Context, *ShelfBookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBookToShelf not implemented")
+}
+func (UnimplementedBookServiceServer) RemoveBookFromShelf(context.Context, *ShelfBookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBookFromShelf not implemented")
+}
+func (UnimplementedBookServiceServer) MoveBookOnShelf(context.Context, *MoveShelfBookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveBookOnShelf not implemented")
+}
+func (UnimplementedBookServiceServer) ListShelfBooks(context.Context, *ShelfBooksRequest) (*ShelfBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShelfBooks not implemented")
+}
+func (UnimplementedBookServiceServer) SetGoal(context.Context, *SetGoalRequest) (*Goal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGoal not implemented")
+}
+func (UnimplementedBookServiceServer) ListGoals(context.Context, *GoalListRequest) (*GoalListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGoals not implemented")
+}
+func (UnimplementedBookServiceServer) DeleteGoal(context.Context, *GoalPK) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGoal not implemented")
+}
+func (UnimplementedBookServiceServer) GetGoalProgress(context.Context, *GoalPK) (*GoalProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoalProgress not implemented")
+}
+func (UnimplementedBookServiceServer) GetStats(context.Context, *StatsRequest) (*Stats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedBookServiceServer) CreateNote(context.Context, *CreateNoteRequest) (*Note, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNote not implemented")
+}
+func (UnimplementedBookServiceServer) GetNote(context.Context, *NotePK) (*Note, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNote not implemented")
+}
+func (UnimplementedBookServiceServer) UpdateNote(context.Context, *UpdateNoteRequest) (*Note, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNote not implemented")
+}
+func (UnimplementedBookServiceServer) DeleteNote(context.Context, *NotePK) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNote not implemented")
+}
+func (UnimplementedBookServiceServer) ListBookNotes(context.Context, *BookNotesRequest) (*NoteListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookNotes not implemented")
+}
+func (UnimplementedBookServiceServer) ListNotes(context.Context, *NoteListRequest) (*NoteListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotes not implemented")
+}
+func (UnimplementedBookServiceServer) LendBook(context.Context, *LendBookRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LendBook not implemented")
+}
+func (UnimplementedBookServiceServer) ReturnBook(context.Context, *ReturnBookRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnBook not implemented")
+}
+func (UnimplementedBookServiceServer) ListLoans(context.Context, *LoanListRequest) (*LoanListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoans not implemented")
+}
+func (UnimplementedBookServiceServer) CreateSeries(context.Context, *CreateSeriesRequest) (*Series, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeries not implemented")
+}
+func (UnimplementedBookServiceServer) UpdateSeries(context.Context, *UpdateSeriesRequest) (*Series, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeries not implemented")
+}
+func (UnimplementedBookServiceServer) DeleteSeries(context.Context, *SeriesPK) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSeries not implemented")
+}
+func (UnimplementedBookServiceServer) ListSeries(context.Context, *SeriesListRequest) (*SeriesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeries not implemented")
+}
+func (UnimplementedBookServiceServer) SetBookSeries(context.Context, *SetBookSeriesRequest) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBookSeries not implemented")
+}
+func (UnimplementedBookServiceServer) NextInSeries(context.Context, *SeriesPK) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextInSeries not implemented")
+}
+func (UnimplementedBookServiceServer) GetWork(context.Context, *WorkPK) (*Work, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWork not implemented")
+}
+func (UnimplementedBookServiceServer) ListEditions(context.Context, *EditionListRequest) (*BookListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEditions not implemented")
+}
+func (UnimplementedBookServiceServer) SetWorkStatus(context.Context, *SetWorkStatusRequest) (*Work, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkStatus not implemented")
+}
+func (UnimplementedBookServiceServer) CreateLocation(context.Context, *CreateLocationRequest) (*Location, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLocation not implemented")
+}
+func (UnimplementedBookServiceServer) UpdateLocation(context.Context, *UpdateLocationRequest) (*Location, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLocation not implemented")
+}
+func (UnimplementedBookServiceServer) DeleteLocation(context.Context, *LocationPK) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLocation not implemented")
+}
+func (UnimplementedBookServiceServer) ListLocations(context.Context, *LocationListRequest) (*LocationListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLocations not implemented")
+}
+func (UnimplementedBookServiceServer) AddCopy(context.Context, *CreateCopyRequest) (*Copy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCopy not implemented")
+}
+func (UnimplementedBookServiceServer) UpdateCopy(context.Context, *UpdateCopyRequest) (*Copy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCopy not implemented")
+}
+func (UnimplementedBookServiceServer) DeleteCopy(context.Context, *CopyPK) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCopy not implemented")
+}
+func (UnimplementedBookServiceServer) ListCopies(context.Context, *CopyListRequest) (*CopyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCopies not implemented")
+}
+func (UnimplementedBookServiceServer) MoveCopy(context.Context, *MoveCopyRequest) (*Copy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCopy not implemented")
+}
+func (UnimplementedBookServiceServer) StartReread(context.Context, *BookPK) (*Read, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartReread not implemented")
+}
+func (UnimplementedBookServiceServer) AbandonRead(context.Context, *BookPK) (*Read, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbandonRead not implemented")
+}
+func (UnimplementedBookServiceServer) ListReads(context.Context, *ReadListRequest) (*ReadListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReads not implemented")
+}
+func (UnimplementedBookServiceServer) GetAuthorList(context.Context, *AuthorListRequest) (*AuthorListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorList not implemented")
+}
+func (UnimplementedBookServiceServer) mustEmbedUnimplementedBookServiceServer() {}
+
+// UnsafeBookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookServiceServer will
+// result in compilation errors.
+type UnsafeBookServiceServer interface {
 	mustEmbedUnimplementedBookServiceServer()
 }
 
-// UnimplementedBookServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedBookServiceServer struct {
+func RegisterBookServiceServer(s grpc.ServiceRegistrar, srv BookServiceServer) {
+	s.RegisterService(&BookService_ServiceDesc, srv)
+}
+
+func _BookService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).Create(ctx, req.(*CreateBook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetByID(ctx, req.(*BookPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetList(ctx, req.(*BookListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).Update(ctx, req.(*UpdateBook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdatePatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePatchBook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdatePatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_UpdatePatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdatePatch(ctx, req.(*UpdatePatchBook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).Delete(ctx, req.(*DeleteBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetBookByTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookByTitle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetBookByTitle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetBookByTitle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetBookByTitle(ctx, req.(*BookByTitle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetStatusHistory(ctx, req.(*BookPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_UpdateProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateProgress(ctx, req.(*UpdateProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_SetOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).SetOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_SetOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).SetOwnership(ctx, req.(*SetOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_MoveToOwned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToOwnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).MoveToOwned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_MoveToOwned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).MoveToOwned(ctx, req.(*MoveToOwnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListDueSoon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DueSoonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListDueSoon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ListDueSoon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListDueSoon(ctx, req.(*DueSoonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_StartSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).StartSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_StartSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).StartSession(ctx, req.(*StartSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_EndSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).EndSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_EndSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).EndSession(ctx, req.(*EndSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListSessions(ctx, req.(*SessionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_UpdateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateReview(ctx, req.(*UpdateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteReview(ctx, req.(*ReviewPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ListReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListReviews(ctx, req.(*ReviewListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_CreateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_RenameTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_MergeTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteTag(ctx, req.(*TagPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListTags(ctx, req.(*TagListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_SetBookTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBookTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).SetBookTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_SetBookTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).SetBookTags(ctx, req.(*SetBookTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_CreateShelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShelfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CreateShelf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_CreateShelf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CreateShelf(ctx, req.(*CreateShelfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetShelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShelfPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetShelf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetShelf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetShelf(ctx, req.(*ShelfPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListShelves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShelfListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListShelves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ListShelves_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListShelves(ctx, req.(*ShelfListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateShelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShelfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateShelf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_UpdateShelf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateShelf(ctx, req.(*UpdateShelfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_DeleteShelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShelfPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteShelf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_DeleteShelf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteShelf(ctx, req.(*ShelfPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_AddBookToShelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShelfBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).AddBookToShelf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_AddBookToShelf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).AddBookToShelf(ctx, req.(*ShelfBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_RemoveBookFromShelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShelfBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).RemoveBookFromShelf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_RemoveBookFromShelf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).RemoveBookFromShelf(ctx, req.(*ShelfBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_MoveBookOnShelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveShelfBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).MoveBookOnShelf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_MoveBookOnShelf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).MoveBookOnShelf(ctx, req.(*MoveShelfBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListShelfBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShelfBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListShelfBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ListShelfBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListShelfBooks(ctx, req.(*ShelfBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_SetGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).SetGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_SetGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).SetGoal(ctx, req.(*SetGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListGoals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoalListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListGoals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ListGoals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListGoals(ctx, req.(*GoalListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_DeleteGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoalPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_DeleteGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteGoal(ctx, req.(*GoalPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetGoalProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoalPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetGoalProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetGoalProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetGoalProgress(ctx, req.(*GoalPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetStats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_CreateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CreateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_CreateNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CreateNote(ctx, req.(*CreateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotePK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetNote(ctx, req.(*NotePK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_UpdateNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateNote(ctx, req.(*UpdateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_DeleteNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotePK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_DeleteNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteNote(ctx, req.(*NotePK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListBookNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListBookNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ListBookNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListBookNotes(ctx, req.(*BookNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ListNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListNotes(ctx, req.(*NoteListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_LendBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LendBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).LendBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_LendBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).LendBook(ctx, req.(*LendBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ReturnBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ReturnBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ReturnBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ReturnBook(ctx, req.(*ReturnBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoanListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ListLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListLoans(ctx, req.(*LoanListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_CreateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CreateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_CreateSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CreateSeries(ctx, req.(*CreateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_UpdateSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateSeries(ctx, req.(*UpdateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_DeleteSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeriesPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_DeleteSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteSeries(ctx, req.(*SeriesPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeriesListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ListSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListSeries(ctx, req.(*SeriesListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_SetBookSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBookSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).SetBookSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_SetBookSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).SetBookSeries(ctx, req.(*SetBookSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_NextInSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeriesPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).NextInSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_NextInSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).NextInSeries(ctx, req.(*SeriesPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetWork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetWork(ctx, req.(*WorkPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListEditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListEditions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ListEditions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListEditions(ctx, req.(*EditionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_SetWorkStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorkStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).SetWorkStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_SetWorkStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).SetWorkStatus(ctx, req.(*SetWorkStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedBookServiceServer) Create(context.Context, *CreateBook) (*OneBookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
-}
-func (UnimplementedBookServiceServer) GetByID(context.Context, *BookPK) (*Book, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
-}
-func (UnimplementedBookServiceServer) GetList(context.Context, *BookListRequest) (*BookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
-}
-func (UnimplementedBookServiceServer) Update(context.Context, *UpdateBook) (*Book, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
-}
-func (UnimplementedBookServiceServer) UpdatePatch(context.Context, *UpdatePatchBook) (*OneBookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePatch not implemented")
+func _BookService_CreateLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CreateLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_CreateLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CreateLocation(ctx, req.(*CreateLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBookServiceServer) Delete(context.Context, *BookPK) (*BookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+
+func _BookService_UpdateLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_UpdateLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateLocation(ctx, req.(*UpdateLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBookServiceServer) GetBookByTitle(context.Context, *BookByTitle) (*BookResponseByItem, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBookByTitle not implemented")
+
+func _BookService_DeleteLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocationPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_DeleteLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteLocation(ctx, req.(*LocationPK))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBookServiceServer) mustEmbedUnimplementedBookServiceServer() {}
 
-// UnsafeBookServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BookServiceServer will
-// result in compilation errors.
-type UnsafeBookServiceServer interface {
-	mustEmbedUnimplementedBookServiceServer()
+func _BookService_ListLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocationListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ListLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListLocations(ctx, req.(*LocationListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBookServiceServer(s grpc.ServiceRegistrar, srv BookServiceServer) {
-	s.RegisterService(&BookService_ServiceDesc, srv)
+func _BookService_AddCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).AddCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_AddCopy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).AddCopy(ctx, req.(*CreateCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BookService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBook)
+func _BookService_UpdateCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCopyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookServiceServer).Create(ctx, in)
+		return srv.(BookServiceServer).UpdateCopy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookService_Create_FullMethodName,
+		FullMethod: BookService_UpdateCopy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookServiceServer).Create(ctx, req.(*CreateBook))
+		return srv.(BookServiceServer).UpdateCopy(ctx, req.(*UpdateCopyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BookPK)
+func _BookService_DeleteCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyPK)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookServiceServer).GetByID(ctx, in)
+		return srv.(BookServiceServer).DeleteCopy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookService_GetByID_FullMethodName,
+		FullMethod: BookService_DeleteCopy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookServiceServer).GetByID(ctx, req.(*BookPK))
+		return srv.(BookServiceServer).DeleteCopy(ctx, req.(*CopyPK))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BookListRequest)
+func _BookService_ListCopies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookServiceServer).GetList(ctx, in)
+		return srv.(BookServiceServer).ListCopies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookService_GetList_FullMethodName,
+		FullMethod: BookService_ListCopies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookServiceServer).GetList(ctx, req.(*BookListRequest))
+		return srv.(BookServiceServer).ListCopies(ctx, req.(*CopyListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBook)
+func _BookService_MoveCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCopyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookServiceServer).Update(ctx, in)
+		return srv.(BookServiceServer).MoveCopy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookService_Update_FullMethodName,
+		FullMethod: BookService_MoveCopy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookServiceServer).Update(ctx, req.(*UpdateBook))
+		return srv.(BookServiceServer).MoveCopy(ctx, req.(*MoveCopyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookService_UpdatePatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePatchBook)
+func _BookService_StartReread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookPK)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookServiceServer).UpdatePatch(ctx, in)
+		return srv.(BookServiceServer).StartReread(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookService_UpdatePatch_FullMethodName,
+		FullMethod: BookService_StartReread_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookServiceServer).UpdatePatch(ctx, req.(*UpdatePatchBook))
+		return srv.(BookServiceServer).StartReread(ctx, req.(*BookPK))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BookService_AbandonRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BookPK)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookServiceServer).Delete(ctx, in)
+		return srv.(BookServiceServer).AbandonRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookService_Delete_FullMethodName,
+		FullMethod: BookService_AbandonRead_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookServiceServer).Delete(ctx, req.(*BookPK))
+		return srv.(BookServiceServer).AbandonRead(ctx, req.(*BookPK))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookService_GetBookByTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BookByTitle)
+func _BookService_ListReads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookServiceServer).GetBookByTitle(ctx, in)
+		return srv.(BookServiceServer).ListReads(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookService_GetBookByTitle_FullMethodName,
+		FullMethod: BookService_ListReads_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookServiceServer).GetBookByTitle(ctx, req.(*BookByTitle))
+		return srv.(BookServiceServer).ListReads(ctx, req.(*ReadListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetAuthorList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetAuthorList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetAuthorList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetAuthorList(ctx, req.(*AuthorListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -325,6 +2405,258 @@ var BookService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBookByTitle",
 			Handler:    _BookService_GetBookByTitle_Handler,
 		},
+		{
+			MethodName: "GetStatusHistory",
+			Handler:    _BookService_GetStatusHistory_Handler,
+		},
+		{
+			MethodName: "UpdateProgress",
+			Handler:    _BookService_UpdateProgress_Handler,
+		},
+		{
+			MethodName: "SetOwnership",
+			Handler:    _BookService_SetOwnership_Handler,
+		},
+		{
+			MethodName: "MoveToOwned",
+			Handler:    _BookService_MoveToOwned_Handler,
+		},
+		{
+			MethodName: "ListDueSoon",
+			Handler:    _BookService_ListDueSoon_Handler,
+		},
+		{
+			MethodName: "StartSession",
+			Handler:    _BookService_StartSession_Handler,
+		},
+		{
+			MethodName: "EndSession",
+			Handler:    _BookService_EndSession_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _BookService_ListSessions_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _BookService_CreateReview_Handler,
+		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _BookService_UpdateReview_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _BookService_DeleteReview_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _BookService_ListReviews_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _BookService_CreateTag_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _BookService_RenameTag_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _BookService_MergeTags_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _BookService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _BookService_ListTags_Handler,
+		},
+		{
+			MethodName: "SetBookTags",
+			Handler:    _BookService_SetBookTags_Handler,
+		},
+		{
+			MethodName: "CreateShelf",
+			Handler:    _BookService_CreateShelf_Handler,
+		},
+		{
+			MethodName: "GetShelf",
+			Handler:    _BookService_GetShelf_Handler,
+		},
+		{
+			MethodName: "ListShelves",
+			Handler:    _BookService_ListShelves_Handler,
+		},
+		{
+			MethodName: "UpdateShelf",
+			Handler:    _BookService_UpdateShelf_Handler,
+		},
+		{
+			MethodName: "DeleteShelf",
+			Handler:    _BookService_DeleteShelf_Handler,
+		},
+		{
+			MethodName: "AddBookToShelf",
+			Handler:    _BookService_AddBookToShelf_Handler,
+		},
+		{
+			MethodName: "RemoveBookFromShelf",
+			Handler:    _BookService_RemoveBookFromShelf_Handler,
+		},
+		{
+			MethodName: "MoveBookOnShelf",
+			Handler:    _BookService_MoveBookOnShelf_Handler,
+		},
+		{
+			MethodName: "ListShelfBooks",
+			Handler:    _BookService_ListShelfBooks_Handler,
+		},
+		{
+			MethodName: "SetGoal",
+			Handler:    _BookService_SetGoal_Handler,
+		},
+		{
+			MethodName: "ListGoals",
+			Handler:    _BookService_ListGoals_Handler,
+		},
+		{
+			MethodName: "DeleteGoal",
+			Handler:    _BookService_DeleteGoal_Handler,
+		},
+		{
+			MethodName: "GetGoalProgress",
+			Handler:    _BookService_GetGoalProgress_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _BookService_GetStats_Handler,
+		},
+		{
+			MethodName: "CreateNote",
+			Handler:    _BookService_CreateNote_Handler,
+		},
+		{
+			MethodName: "GetNote",
+			Handler:    _BookService_GetNote_Handler,
+		},
+		{
+			MethodName: "UpdateNote",
+			Handler:    _BookService_UpdateNote_Handler,
+		},
+		{
+			MethodName: "DeleteNote",
+			Handler:    _BookService_DeleteNote_Handler,
+		},
+		{
+			MethodName: "ListBookNotes",
+			Handler:    _BookService_ListBookNotes_Handler,
+		},
+		{
+			MethodName: "ListNotes",
+			Handler:    _BookService_ListNotes_Handler,
+		},
+		{
+			MethodName: "LendBook",
+			Handler:    _BookService_LendBook_Handler,
+		},
+		{
+			MethodName: "ReturnBook",
+			Handler:    _BookService_ReturnBook_Handler,
+		},
+		{
+			MethodName: "ListLoans",
+			Handler:    _BookService_ListLoans_Handler,
+		},
+		{
+			MethodName: "CreateSeries",
+			Handler:    _BookService_CreateSeries_Handler,
+		},
+		{
+			MethodName: "UpdateSeries",
+			Handler:    _BookService_UpdateSeries_Handler,
+		},
+		{
+			MethodName: "DeleteSeries",
+			Handler:    _BookService_DeleteSeries_Handler,
+		},
+		{
+			MethodName: "ListSeries",
+			Handler:    _BookService_ListSeries_Handler,
+		},
+		{
+			MethodName: "SetBookSeries",
+			Handler:    _BookService_SetBookSeries_Handler,
+		},
+		{
+			MethodName: "NextInSeries",
+			Handler:    _BookService_NextInSeries_Handler,
+		},
+		{
+			MethodName: "GetWork",
+			Handler:    _BookService_GetWork_Handler,
+		},
+		{
+			MethodName: "ListEditions",
+			Handler:    _BookService_ListEditions_Handler,
+		},
+		{
+			MethodName: "SetWorkStatus",
+			Handler:    _BookService_SetWorkStatus_Handler,
+		},
+		{
+			MethodName: "CreateLocation",
+			Handler:    _BookService_CreateLocation_Handler,
+		},
+		{
+			MethodName: "UpdateLocation",
+			Handler:    _BookService_UpdateLocation_Handler,
+		},
+		{
+			MethodName: "DeleteLocation",
+			Handler:    _BookService_DeleteLocation_Handler,
+		},
+		{
+			MethodName: "ListLocations",
+			Handler:    _BookService_ListLocations_Handler,
+		},
+		{
+			MethodName: "AddCopy",
+			Handler:    _BookService_AddCopy_Handler,
+		},
+		{
+			MethodName: "UpdateCopy",
+			Handler:    _BookService_UpdateCopy_Handler,
+		},
+		{
+			MethodName: "DeleteCopy",
+			Handler:    _BookService_DeleteCopy_Handler,
+		},
+		{
+			MethodName: "ListCopies",
+			Handler:    _BookService_ListCopies_Handler,
+		},
+		{
+			MethodName: "MoveCopy",
+			Handler:    _BookService_MoveCopy_Handler,
+		},
+		{
+			MethodName: "StartReread",
+			Handler:    _BookService_StartReread_Handler,
+		},
+		{
+			MethodName: "AbandonRead",
+			Handler:    _BookService_AbandonRead_Handler,
+		},
+		{
+			MethodName: "ListReads",
+			Handler:    _BookService_ListReads_Handler,
+		},
+		{
+			MethodName: "GetAuthorList",
+			Handler:    _BookService_GetAuthorList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "book_service.proto",
@@ -0,0 +1,650 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.12.4
+// source: goal.proto
+
+package book_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GoalMetric int32
+
+const (
+	GoalMetric_BOOKS GoalMetric = 0
+	GoalMetric_PAGES GoalMetric = 1
+)
+
+// Enum value maps for GoalMetric.
+var (
+	GoalMetric_name = map[int32]string{
+		0: "BOOKS",
+		1: "PAGES",
+	}
+	GoalMetric_value = map[string]int32{
+		"BOOKS": 0,
+		"PAGES": 1,
+	}
+)
+
+func (x GoalMetric) Enum() *GoalMetric {
+	p := new(GoalMetric)
+	*p = x
+	return p
+}
+
+func (x GoalMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GoalMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_goal_proto_enumTypes[0].Descriptor()
+}
+
+func (GoalMetric) Type() protoreflect.EnumType {
+	return &file_goal_proto_enumTypes[0]
+}
+
+func (x GoalMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GoalMetric.Descriptor instead.
+func (GoalMetric) EnumDescriptor() ([]byte, []int) {
+	return file_goal_proto_rawDescGZIP(), []int{0}
+}
+
+type Goal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Year      int32      `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Metric    GoalMetric `protobuf:"varint,3,opt,name=metric,proto3,enum=book_service.GoalMetric" json:"metric,omitempty"`
+	Target    int32      `protobuf:"varint,4,opt,name=target,proto3" json:"target,omitempty"`
+	CreatedAt string     `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Goal) Reset() {
+	*x = Goal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_goal_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Goal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Goal) ProtoMessage() {}
+
+func (x *Goal) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Goal.ProtoReflect.Descriptor instead.
+func (*Goal) Descriptor() ([]byte, []int) {
+	return file_goal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Goal) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Goal) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Goal) GetMetric() GoalMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return GoalMetric_BOOKS
+}
+
+func (x *Goal) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *Goal) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// SetGoalRequest creates the goal for year and metric or replaces its target.
+type SetGoalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year   int32      `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Metric GoalMetric `protobuf:"varint,2,opt,name=metric,proto3,enum=book_service.GoalMetric" json:"metric,omitempty"`
+	Target int32      `protobuf:"varint,3,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *SetGoalRequest) Reset() {
+	*x = SetGoalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_goal_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGoalRequest) ProtoMessage() {}
+
+func (x *SetGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGoalRequest.ProtoReflect.Descriptor instead.
+func (*SetGoalRequest) Descriptor() ([]byte, []int) {
+	return file_goal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetGoalRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SetGoalRequest) GetMetric() GoalMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return GoalMetric_BOOKS
+}
+
+func (x *SetGoalRequest) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+type GoalPK struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GoalPK) Reset() {
+	*x = GoalPK{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_goal_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoalPK) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalPK) ProtoMessage() {}
+
+func (x *GoalPK) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalPK.ProtoReflect.Descriptor instead.
+func (*GoalPK) Descriptor() ([]byte, []int) {
+	return file_goal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GoalPK) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GoalListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"` // 0 lists every year
+}
+
+func (x *GoalListRequest) Reset() {
+	*x = GoalListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_goal_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoalListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalListRequest) ProtoMessage() {}
+
+func (x *GoalListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalListRequest.ProtoReflect.Descriptor instead.
+func (*GoalListRequest) Descriptor() ([]byte, []int) {
+	return file_goal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GoalListRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type GoalListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Goals []*Goal `protobuf:"bytes,2,rep,name=goals,proto3" json:"goals,omitempty"`
+}
+
+func (x *GoalListResponse) Reset() {
+	*x = GoalListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_goal_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoalListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalListResponse) ProtoMessage() {}
+
+func (x *GoalListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalListResponse.ProtoReflect.Descriptor instead.
+func (*GoalListResponse) Descriptor() ([]byte, []int) {
+	return file_goal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GoalListResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GoalListResponse) GetGoals() []*Goal {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+type GoalProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Goal            *Goal   `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	Done            int32   `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`              // books or pages finished in the year
+	Expected        float32 `protobuf:"fixed32,3,opt,name=expected,proto3" json:"expected,omitempty"`     // what an even pace would have reached by today
+	Difference      float32 `protobuf:"fixed32,4,opt,name=difference,proto3" json:"difference,omitempty"` // done - expected, negative when behind
+	Ahead           bool    `protobuf:"varint,5,opt,name=ahead,proto3" json:"ahead,omitempty"`
+	PercentComplete float32 `protobuf:"fixed32,6,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	Remaining       int32   `protobuf:"varint,7,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	DaysLeft        int32   `protobuf:"varint,8,opt,name=days_left,json=daysLeft,proto3" json:"days_left,omitempty"`
+}
+
+func (x *GoalProgress) Reset() {
+	*x = GoalProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_goal_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoalProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalProgress) ProtoMessage() {}
+
+func (x *GoalProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_goal_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalProgress.ProtoReflect.Descriptor instead.
+func (*GoalProgress) Descriptor() ([]byte, []int) {
+	return file_goal_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GoalProgress) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+func (x *GoalProgress) GetDone() int32 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetExpected() float32 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetDifference() float32 {
+	if x != nil {
+		return x.Difference
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetAhead() bool {
+	if x != nil {
+		return x.Ahead
+	}
+	return false
+}
+
+func (x *GoalProgress) GetPercentComplete() float32 {
+	if x != nil {
+		return x.PercentComplete
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetDaysLeft() int32 {
+	if x != nil {
+		return x.DaysLeft
+	}
+	return 0
+}
+
+var File_goal_proto protoreflect.FileDescriptor
+
+var file_goal_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x62, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x04, 0x47,
+	0x6f, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x6e, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x22, 0x18, 0x0a, 0x06, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x4b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x6f,
+	0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x22, 0x52, 0x0a, 0x10, 0x47, 0x6f, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x67,
+	0x6f, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x05,
+	0x67, 0x6f, 0x61, 0x6c, 0x73, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x6f,
+	0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61,
+	0x68, 0x65, 0x61, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x64, 0x61, 0x79, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x2a, 0x22, 0x0a, 0x0a, 0x47, 0x6f,
+	0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x4f, 0x4f, 0x4b,
+	0x53, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x47, 0x45, 0x53, 0x10, 0x01, 0x42, 0x17,
+	0x5a, 0x15, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_goal_proto_rawDescOnce sync.Once
+	file_goal_proto_rawDescData = file_goal_proto_rawDesc
+)
+
+func file_goal_proto_rawDescGZIP() []byte {
+	file_goal_proto_rawDescOnce.Do(func() {
+		file_goal_proto_rawDescData = protoimpl.X.CompressGZIP(file_goal_proto_rawDescData)
+	})
+	return file_goal_proto_rawDescData
+}
+
+var file_goal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_goal_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_goal_proto_goTypes = []interface{}{
+	(GoalMetric)(0),          // 0: book_service.GoalMetric
+	(*Goal)(nil),             // 1: book_service.Goal
+	(*SetGoalRequest)(nil),   // 2: book_service.SetGoalRequest
+	(*GoalPK)(nil),           // 3: book_service.GoalPK
+	(*GoalListRequest)(nil),  // 4: book_service.GoalListRequest
+	(*GoalListResponse)(nil), // 5: book_service.GoalListResponse
+	(*GoalProgress)(nil),     // 6: book_service.GoalProgress
+}
+var file_goal_proto_depIdxs = []int32{
+	0, // 0: book_service.Goal.metric:type_name -> book_service.GoalMetric
+	0, // 1: book_service.SetGoalRequest.metric:type_name -> book_service.GoalMetric
+	1, // 2: book_service.GoalListResponse.goals:type_name -> book_service.Goal
+	1, // 3: book_service.GoalProgress.goal:type_name -> book_service.Goal
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_goal_proto_init() }
+func file_goal_proto_init() {
+	if File_goal_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_goal_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Goal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_goal_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGoalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_goal_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoalPK); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_goal_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoalListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_goal_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoalListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_goal_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoalProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_goal_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_goal_proto_goTypes,
+		DependencyIndexes: file_goal_proto_depIdxs,
+		EnumInfos:         file_goal_proto_enumTypes,
+		MessageInfos:      file_goal_proto_msgTypes,
+	}.Build()
+	File_goal_proto = out.File
+	file_goal_proto_rawDesc = nil
+	file_goal_proto_goTypes = nil
+	file_goal_proto_depIdxs = nil
+}
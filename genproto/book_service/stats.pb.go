@@ -0,0 +1,579 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.12.4
+// source: stats.proto
+
+package book_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From       string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`                                // YYYY-MM-DD, inclusive, empty for no lower bound
+	To         string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`                                    // YYYY-MM-DD, inclusive, empty for no upper bound
+	TopAuthors int32  `protobuf:"varint,3,opt,name=top_authors,json=topAuthors,proto3" json:"top_authors,omitempty"` // defaults to 10
+}
+
+func (x *StatsRequest) Reset() {
+	*x = StatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stats_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsRequest) ProtoMessage() {}
+
+func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StatsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *StatsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *StatsRequest) GetTopAuthors() int32 {
+	if x != nil {
+		return x.TopAuthors
+	}
+	return 0
+}
+
+type PeriodStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period string `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"` // YYYY-MM or YYYY
+	Books  int32  `protobuf:"varint,2,opt,name=books,proto3" json:"books,omitempty"`
+	Pages  int32  `protobuf:"varint,3,opt,name=pages,proto3" json:"pages,omitempty"`
+}
+
+func (x *PeriodStat) Reset() {
+	*x = PeriodStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stats_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeriodStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodStat) ProtoMessage() {}
+
+func (x *PeriodStat) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodStat.ProtoReflect.Descriptor instead.
+func (*PeriodStat) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PeriodStat) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *PeriodStat) GetBooks() int32 {
+	if x != nil {
+		return x.Books
+	}
+	return 0
+}
+
+func (x *PeriodStat) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+type AuthorStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorId int32  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Books    int32  `protobuf:"varint,3,opt,name=books,proto3" json:"books,omitempty"`
+}
+
+func (x *AuthorStat) Reset() {
+	*x = AuthorStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stats_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorStat) ProtoMessage() {}
+
+func (x *AuthorStat) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorStat.ProtoReflect.Descriptor instead.
+func (*AuthorStat) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuthorStat) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *AuthorStat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuthorStat) GetBooks() int32 {
+	if x != nil {
+		return x.Books
+	}
+	return 0
+}
+
+type StatusStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status BookStatus `protobuf:"varint,1,opt,name=status,proto3,enum=book_service.BookStatus" json:"status,omitempty"`
+	Books  int32      `protobuf:"varint,2,opt,name=books,proto3" json:"books,omitempty"`
+}
+
+func (x *StatusStat) Reset() {
+	*x = StatusStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stats_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusStat) ProtoMessage() {}
+
+func (x *StatusStat) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusStat.ProtoReflect.Descriptor instead.
+func (*StatusStat) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StatusStat) GetStatus() BookStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookStatus_NEW
+}
+
+func (x *StatusStat) GetBooks() int32 {
+	if x != nil {
+		return x.Books
+	}
+	return 0
+}
+
+// Stats covers the read-throughs finished within the requested range, so a
+// book read twice counts twice, except for statuses and the ownership counts
+// which describe the whole library as it is now.
+type Stats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BooksFinished       int32         `protobuf:"varint,1,opt,name=books_finished,json=booksFinished,proto3" json:"books_finished,omitempty"`
+	PagesRead           int32         `protobuf:"varint,2,opt,name=pages_read,json=pagesRead,proto3" json:"pages_read,omitempty"`
+	AveragePages        float32       `protobuf:"fixed32,3,opt,name=average_pages,json=averagePages,proto3" json:"average_pages,omitempty"`
+	AverageDaysToFinish float32       `protobuf:"fixed32,4,opt,name=average_days_to_finish,json=averageDaysToFinish,proto3" json:"average_days_to_finish,omitempty"`
+	PerMonth            []*PeriodStat `protobuf:"bytes,5,rep,name=per_month,json=perMonth,proto3" json:"per_month,omitempty"`
+	PerYear             []*PeriodStat `protobuf:"bytes,6,rep,name=per_year,json=perYear,proto3" json:"per_year,omitempty"`
+	TopAuthors          []*AuthorStat `protobuf:"bytes,7,rep,name=top_authors,json=topAuthors,proto3" json:"top_authors,omitempty"`
+	Statuses            []*StatusStat `protobuf:"bytes,8,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	OwnedBooks          int32         `protobuf:"varint,9,opt,name=owned_books,json=ownedBooks,proto3" json:"owned_books,omitempty"` // borrowed books are not counted
+	BorrowedBooks       int32         `protobuf:"varint,10,opt,name=borrowed_books,json=borrowedBooks,proto3" json:"borrowed_books,omitempty"`
+	WishlistBooks       int32         `protobuf:"varint,11,opt,name=wishlist_books,json=wishlistBooks,proto3" json:"wishlist_books,omitempty"`
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stats_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Stats) GetBooksFinished() int32 {
+	if x != nil {
+		return x.BooksFinished
+	}
+	return 0
+}
+
+func (x *Stats) GetPagesRead() int32 {
+	if x != nil {
+		return x.PagesRead
+	}
+	return 0
+}
+
+func (x *Stats) GetAveragePages() float32 {
+	if x != nil {
+		return x.AveragePages
+	}
+	return 0
+}
+
+func (x *Stats) GetAverageDaysToFinish() float32 {
+	if x != nil {
+		return x.AverageDaysToFinish
+	}
+	return 0
+}
+
+func (x *Stats) GetPerMonth() []*PeriodStat {
+	if x != nil {
+		return x.PerMonth
+	}
+	return nil
+}
+
+func (x *Stats) GetPerYear() []*PeriodStat {
+	if x != nil {
+		return x.PerYear
+	}
+	return nil
+}
+
+func (x *Stats) GetTopAuthors() []*AuthorStat {
+	if x != nil {
+		return x.TopAuthors
+	}
+	return nil
+}
+
+func (x *Stats) GetStatuses() []*StatusStat {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *Stats) GetOwnedBooks() int32 {
+	if x != nil {
+		return x.OwnedBooks
+	}
+	return 0
+}
+
+func (x *Stats) GetBorrowedBooks() int32 {
+	if x != nil {
+		return x.BorrowedBooks
+	}
+	return 0
+}
+
+func (x *Stats) GetWishlistBooks() int32 {
+	if x != nil {
+		return x.WishlistBooks
+	}
+	return 0
+}
+
+var File_stats_proto protoreflect.FileDescriptor
+
+var file_stats_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x0a, 0x62, 0x6f, 0x6f,
+	0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x70, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x74, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x22, 0x50, 0x0a, 0x0a,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x22, 0x53,
+	0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x6f,
+	0x6f, 0x6b, 0x73, 0x22, 0x54, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0xf3, 0x03, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x5f, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x6f, 0x6f,
+	0x6b, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x33,
+	0x0a, 0x16, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x74,
+	0x6f, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x44, 0x61, 0x79, 0x73, 0x54, 0x6f, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x52, 0x08, 0x70, 0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x65,
+	0x72, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x53, 0x74, 0x61, 0x74, 0x52, 0x07, 0x70, 0x65, 0x72, 0x59, 0x65, 0x61, 0x72, 0x12,
+	0x39, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0a,
+	0x74, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x6f,
+	0x6f, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x6f, 0x72, 0x72, 0x6f,
+	0x77, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x73, 0x68,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x77, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x42,
+	0x17, 0x5a, 0x15, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_stats_proto_rawDescOnce sync.Once
+	file_stats_proto_rawDescData = file_stats_proto_rawDesc
+)
+
+func file_stats_proto_rawDescGZIP() []byte {
+	file_stats_proto_rawDescOnce.Do(func() {
+		file_stats_proto_rawDescData = protoimpl.X.CompressGZIP(file_stats_proto_rawDescData)
+	})
+	return file_stats_proto_rawDescData
+}
+
+var file_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_stats_proto_goTypes = []interface{}{
+	(*StatsRequest)(nil), // 0: book_service.StatsRequest
+	(*PeriodStat)(nil),   // 1: book_service.PeriodStat
+	(*AuthorStat)(nil),   // 2: book_service.AuthorStat
+	(*StatusStat)(nil),   // 3: book_service.StatusStat
+	(*Stats)(nil),        // 4: book_service.Stats
+	(BookStatus)(0),      // 5: book_service.BookStatus
+}
+var file_stats_proto_depIdxs = []int32{
+	5, // 0: book_service.StatusStat.status:type_name -> book_service.BookStatus
+	1, // 1: book_service.Stats.per_month:type_name -> book_service.PeriodStat
+	1, // 2: book_service.Stats.per_year:type_name -> book_service.PeriodStat
+	2, // 3: book_service.Stats.top_authors:type_name -> book_service.AuthorStat
+	3, // 4: book_service.Stats.statuses:type_name -> book_service.StatusStat
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_stats_proto_init() }
+func file_stats_proto_init() {
+	if File_stats_proto != nil {
+		return
+	}
+	file_book_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_stats_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stats_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeriodStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stats_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stats_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stats_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_stats_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_stats_proto_goTypes,
+		DependencyIndexes: file_stats_proto_depIdxs,
+		MessageInfos:      file_stats_proto_msgTypes,
+	}.Build()
+	File_stats_proto = out.File
+	file_stats_proto_rawDesc = nil
+	file_stats_proto_goTypes = nil
+	file_stats_proto_depIdxs = nil
+}
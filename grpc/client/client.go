@@ -1,12 +1,99 @@
 package client
 
-import "book/config"
+import (
+	"book/config"
+	"book/genproto/auth_service"
 
-type ServiceManagerI interface{}
+	"context"
+	"errors"
+	"sync"
 
-type grpcClients struct{}
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
 
-func NewGrpcClients(cfg config.Config) (ServiceManagerI, error) {
+type ServiceManagerI interface {
+	UserService() auth_service.UserServiceClient
+	AuthService() auth_service.AuthServiceClient
+	Close() error
+}
+
+var errConnClosed = errors.New("grpc client connection is closed")
+
+type grpcClients struct {
+	authTarget string
+	dialOpts   []grpc.DialOption
+
+	authOnce sync.Once
+	authConn *grpc.ClientConn
+	authErr  error
+}
+
+// NewGrpcClients prepares the clients of the services book_service talks to.
+// Connections are dialled on first use; extra options are appended to the
+// defaults, which lets tests swap the transport for an in-process listener.
+func NewGrpcClients(cfg config.Config, opts ...grpc.DialOption) (ServiceManagerI, error) {
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    cfg.GRPCKeepaliveTime,
+			Timeout: cfg.GRPCKeepaliveTimeout,
+		}),
+	}
+
+	return &grpcClients{
+		authTarget: cfg.AuthServiceHost + cfg.AuthGRPCPort,
+		dialOpts:   append(dialOpts, opts...),
+	}, nil
+}
+
+func (g *grpcClients) UserService() auth_service.UserServiceClient {
+	return auth_service.NewUserServiceClient(g.authConnection())
+}
+
+func (g *grpcClients) AuthService() auth_service.AuthServiceClient {
+	return auth_service.NewAuthServiceClient(g.authConnection())
+}
+
+func (g *grpcClients) Close() error {
+	var err error
+
+	// make sure no dial can start after Close
+	g.authOnce.Do(func() {})
+
+	if g.authConn != nil {
+		err = g.authConn.Close()
+	}
+
+	return err
+}
+
+func (g *grpcClients) authConnection() grpc.ClientConnInterface {
+	g.authOnce.Do(func() {
+		g.authConn, g.authErr = grpc.Dial(g.authTarget, g.dialOpts...)
+	})
+
+	if g.authErr != nil {
+		return failedConn{err: g.authErr}
+	}
+
+	if g.authConn == nil {
+		return failedConn{err: errConnClosed}
+	}
+
+	return g.authConn
+}
+
+// failedConn surfaces a dial error on every call made through it.
+type failedConn struct {
+	err error
+}
+
+func (c failedConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	return c.err
+}
 
-	return &grpcClients{}, nil
+func (c failedConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, c.err
 }
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"book/config"
+	"book/genproto/auth_service"
+
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakeUserService struct {
+	auth_service.UnimplementedUserServiceServer
+}
+
+func (fakeUserService) CheckUser(ctx context.Context, req *auth_service.CheckUserRequest) (*auth_service.CheckUserResponse, error) {
+	known := req.GetName() == "reader" && req.GetSecret() == "reader-secret"
+
+	return &auth_service.CheckUserResponse{Exists: known, Registered: known}, nil
+}
+
+// newBufconnClients serves a fake auth_service over an in-process listener
+// and returns clients dialled through it.
+func newBufconnClients(t *testing.T) ServiceManagerI {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	auth_service.RegisterUserServiceServer(srv, fakeUserService{})
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	cfg := config.Config{
+		AuthServiceHost:      "bufconn",
+		AuthGRPCPort:         ":0",
+		GRPCKeepaliveTime:    time.Minute,
+		GRPCKeepaliveTimeout: time.Second,
+	}
+
+	clients, err := NewGrpcClients(cfg, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}))
+	if err != nil {
+		t.Fatalf("NewGrpcClients: %v", err)
+	}
+	t.Cleanup(func() { clients.Close() })
+
+	return clients
+}
+
+func TestUserServiceOverBufconn(t *testing.T) {
+	clients := newBufconnClients(t)
+
+	tests := []struct {
+		name   string
+		req    *auth_service.CheckUserRequest
+		exists bool
+	}{
+		{"known user", &auth_service.CheckUserRequest{Name: "reader", Secret: "reader-secret"}, true},
+		{"wrong secret", &auth_service.CheckUserRequest{Name: "reader", Secret: "guess"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := clients.UserService().CheckUser(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("CheckUser: %v", err)
+			}
+			if resp.GetExists() != tt.exists {
+				t.Fatalf("exists = %t, want %t", resp.GetExists(), tt.exists)
+			}
+		})
+	}
+}
+
+func TestCloseStopsDialling(t *testing.T) {
+	clients := newBufconnClients(t)
+
+	if err := clients.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	_, err := clients.UserService().CheckUser(context.Background(), &auth_service.CheckUserRequest{})
+	if err == nil {
+		t.Fatal("CheckUser after Close succeeded, want an error")
+	}
+}
@@ -4,19 +4,23 @@ import (
 	"book/config"
 	"book/genproto/book_service"
 	"book/grpc/client"
+	"book/grpc/interceptor"
 	"book/grpc/service"
 	"book/pkg/logger"
+	"book/pkg/metadata"
 	"book/storage"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
-func SetUpServer(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvc client.ServiceManagerI) (grpcServer *grpc.Server) {
+func SetUpServer(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvc client.ServiceManagerI, users interceptor.UserStoreI, provider metadata.Provider) (grpcServer *grpc.Server) {
 
-	grpcServer = grpc.NewServer()
+	grpcServer = grpc.NewServer(
+		grpc.UnaryInterceptor(interceptor.NewAuthInterceptor(cfg, log, users)),
+	)
 
-	book_service.RegisterBookServiceServer(grpcServer, service.NewBookService(cfg, log, strg, srvc))
+	book_service.RegisterBookServiceServer(grpcServer, service.NewBookService(cfg, log, strg, srvc, provider))
 
 	reflection.Register(grpcServer)
 	return
@@ -0,0 +1,129 @@
+package interceptor
+
+import (
+	"book/config"
+	"book/pkg/logger"
+
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	KeyMetadataKey       = "key"
+	SignMetadataKey      = "sign"
+	TimestampMetadataKey = "timestamp"
+	NonceMetadataKey     = "nonce"
+
+	// maxNonceLength bounds what a signed caller can make the nonce set hold.
+	maxNonceLength = 64
+)
+
+// Sign computes the hex encoded HMAC-SHA256 a client sends in the "sign"
+// metadata. The MAC is keyed with the service secret followed by the user's
+// secret and covers the full method name, the unix timestamp, the nonce and
+// the deterministically marshaled request body.
+func Sign(secretKey, userSecret, method, timestamp, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secretKey+userSecret))
+	mac.Write([]byte(method))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("\n"))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// MarshalBody is the body encoding Sign expects.
+func MarshalBody(req interface{}) ([]byte, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil, nil
+	}
+
+	return proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+}
+
+// NewAuthInterceptor verifies the key/sign/timestamp/nonce metadata of every
+// unary call and replaces the "user_id" metadata with the id of the signing
+// user. The timestamp has to be within cfg.SignTTL of now and each nonce is
+// accepted once per key while its timestamp is, so a captured call cannot be
+// replayed. The used nonces are kept in memory, per process.
+func NewAuthInterceptor(cfg config.Config, log logger.LoggerI, users UserStoreI) grpc.UnaryServerInterceptor {
+	nonces := newNonceSet()
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		}
+
+		key := firstValue(md, KeyMetadataKey)
+		sign := firstValue(md, SignMetadataKey)
+		timestamp := firstValue(md, TimestampMetadataKey)
+		nonce := firstValue(md, NonceMetadataKey)
+		if key == "" || sign == "" || timestamp == "" || nonce == "" {
+			return nil, status.Error(codes.Unauthenticated, "key, sign, timestamp and nonce are required")
+		}
+		if len(nonce) > maxNonceLength {
+			return nil, status.Errorf(codes.Unauthenticated, "nonce is longer than %d bytes", maxNonceLength)
+		}
+
+		unix, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid timestamp")
+		}
+
+		age := time.Since(time.Unix(unix, 0))
+		if age > cfg.SignTTL || age < -cfg.SignTTL {
+			return nil, status.Error(codes.Unauthenticated, "timestamp is out of the allowed window")
+		}
+
+		user, err := users.GetByKey(ctx, key)
+		if err != nil {
+			log.Warn("!!!AuthInterceptor->GetByKey--->", logger.String("method", info.FullMethod), logger.Error(err))
+			return nil, status.Error(codes.Unauthenticated, "unknown key")
+		}
+
+		body, err := MarshalBody(req)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		expected := Sign(cfg.SecretKey, user.GetSecret(), info.FullMethod, timestamp, nonce, body)
+		if !hmac.Equal([]byte(expected), []byte(sign)) {
+			log.Warn("!!!AuthInterceptor->Sign--->", logger.String("method", info.FullMethod), logger.String("key", key))
+			return nil, status.Error(codes.Unauthenticated, "invalid sign")
+		}
+
+		// only checked once the sign is, so unsigned calls cannot fill the set
+		if !nonces.Use(key+"\n"+nonce, time.Unix(unix, 0).Add(cfg.SignTTL)) {
+			log.Warn("!!!AuthInterceptor->Nonce--->", logger.String("method", info.FullMethod), logger.String("key", key))
+			return nil, status.Error(codes.Unauthenticated, "nonce was already used")
+		}
+
+		md = md.Copy()
+		md.Set(config.UserIDMetadataKey, strconv.Itoa(int(user.GetId())))
+
+		return handler(metadata.NewIncomingContext(ctx, md), req)
+	}
+}
+
+func firstValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
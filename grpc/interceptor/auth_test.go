@@ -0,0 +1,234 @@
+package interceptor
+
+import (
+	"book/config"
+	"book/genproto/auth_service"
+	"book/genproto/book_service"
+	"book/pkg/logger"
+
+	"context"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testMethod = "/book_service.BookService/GetByID"
+
+var testUser = &auth_service.User{
+	Id:     42,
+	Name:   "reader",
+	Key:    "reader-key",
+	Secret: "reader-secret",
+}
+
+func testInterceptor() (grpc.UnaryServerInterceptor, config.Config) {
+	cfg := config.Config{
+		SecretKey: "service-secret",
+		SignTTL:   time.Minute,
+	}
+
+	log := logger.NewLogger("interceptor_test", logger.LevelError)
+
+	return NewAuthInterceptor(cfg, log, NewMemoryUserStore(testUser)), cfg
+}
+
+var lastNonce int64
+
+// signedContext builds the incoming metadata a client signing req with
+// secret would send, with a fresh nonce.
+func signedContext(t *testing.T, cfg config.Config, key, secret string, at time.Time, req interface{}, extra ...string) context.Context {
+	t.Helper()
+
+	nonce := strconv.FormatInt(atomic.AddInt64(&lastNonce, 1), 10)
+
+	return signedContextWithNonce(t, cfg, key, secret, at, nonce, req, extra...)
+}
+
+func signedContextWithNonce(t *testing.T, cfg config.Config, key, secret string, at time.Time, nonce string, req interface{}, extra ...string) context.Context {
+	t.Helper()
+
+	body, err := MarshalBody(req)
+	if err != nil {
+		t.Fatalf("MarshalBody: %v", err)
+	}
+
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	md := metadata.Pairs(
+		KeyMetadataKey, key,
+		TimestampMetadataKey, timestamp,
+		NonceMetadataKey, nonce,
+		SignMetadataKey, Sign(cfg.SecretKey, secret, testMethod, timestamp, nonce, body),
+	)
+	md = metadata.Join(md, metadata.Pairs(extra...))
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	auth, cfg := testInterceptor()
+	req := &book_service.BookPK{Id: 7}
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  interface{}
+		code codes.Code
+	}{
+		{
+			name: "valid sign",
+			ctx:  signedContext(t, cfg, testUser.Key, testUser.Secret, time.Now(), req),
+			req:  req,
+			code: codes.OK,
+		},
+		{
+			name: "sign with the wrong secret",
+			ctx:  signedContext(t, cfg, testUser.Key, "not-the-secret", time.Now(), req),
+			req:  req,
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "body changed after signing",
+			ctx:  signedContext(t, cfg, testUser.Key, testUser.Secret, time.Now(), req),
+			req:  &book_service.BookPK{Id: 8},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "unknown key",
+			ctx:  signedContext(t, cfg, "unknown-key", testUser.Secret, time.Now(), req),
+			req:  req,
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "stale timestamp",
+			ctx:  signedContext(t, cfg, testUser.Key, testUser.Secret, time.Now().Add(-2*cfg.SignTTL), req),
+			req:  req,
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "missing nonce",
+			ctx:  signedContextWithNonce(t, cfg, testUser.Key, testUser.Secret, time.Now(), "", req),
+			req:  req,
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "nonce too long",
+			ctx:  signedContextWithNonce(t, cfg, testUser.Key, testUser.Secret, time.Now(), strings.Repeat("n", maxNonceLength+1), req),
+			req:  req,
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "missing metadata",
+			ctx:  context.Background(),
+			req:  req,
+			code: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return req, nil
+			}
+
+			_, err := auth(tt.ctx, tt.req, info, handler)
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("code = %s, want %s (err: %v)", got, tt.code, err)
+			}
+			if called != (tt.code == codes.OK) {
+				t.Fatalf("handler called = %t, want %t", called, tt.code == codes.OK)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptorOverridesUserID(t *testing.T) {
+	auth, cfg := testInterceptor()
+	req := &book_service.BookPK{Id: 7}
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+
+	// a client must not be able to act as someone else by sending user_id
+	ctx := signedContext(t, cfg, testUser.Key, testUser.Secret, time.Now(), req, config.UserIDMetadataKey, "1")
+
+	var userIDs []string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		userIDs = md.Get(config.UserIDMetadataKey)
+		return req, nil
+	}
+
+	if _, err := auth(ctx, req, info, handler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := strconv.Itoa(int(testUser.Id))
+	if len(userIDs) != 1 || userIDs[0] != want {
+		t.Fatalf("user_id = %v, want [%s]", userIDs, want)
+	}
+}
+
+func TestAuthInterceptorRejectsReplays(t *testing.T) {
+	auth, cfg := testInterceptor()
+	req := &book_service.BookPK{Id: 7}
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+
+	ctx := signedContext(t, cfg, testUser.Key, testUser.Secret, time.Now(), req)
+	if _, err := auth(ctx, req, info, handler); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	if _, err := auth(ctx, req, info, handler); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("replayed call: err = %v, want Unauthenticated", err)
+	}
+
+	// the same request signed again with a new nonce is a new call
+	ctx = signedContext(t, cfg, testUser.Key, testUser.Secret, time.Now(), req)
+	if _, err := auth(ctx, req, info, handler); err != nil {
+		t.Fatalf("call with a new nonce: %v", err)
+	}
+}
+
+func TestNonceSet(t *testing.T) {
+	nonces := newNonceSet()
+
+	if !nonces.Use("a", time.Now().Add(time.Minute)) {
+		t.Fatal("first use of a was rejected")
+	}
+	if nonces.Use("a", time.Now().Add(time.Minute)) {
+		t.Fatal("second use of a was accepted")
+	}
+
+	if !nonces.Use("b", time.Now().Add(-time.Second)) {
+		t.Fatal("first use of b was rejected")
+	}
+	if !nonces.Use("b", time.Now().Add(time.Minute)) {
+		t.Fatal("b was rejected after it expired")
+	}
+
+	nonces.lastPrune = time.Now().Add(-noncePruneInterval)
+	nonces.Use("c", time.Now().Add(-time.Second))
+	nonces.Use("d", time.Now().Add(time.Minute))
+	if _, ok := nonces.seen["c"]; !ok {
+		t.Fatal("c was pruned before the next prune")
+	}
+
+	nonces.lastPrune = time.Now().Add(-noncePruneInterval)
+	nonces.Use("e", time.Now().Add(time.Minute))
+	if _, ok := nonces.seen["c"]; ok {
+		t.Fatal("expired c was not pruned")
+	}
+	if _, ok := nonces.seen["a"]; !ok {
+		t.Fatal("a was pruned before it expired")
+	}
+}
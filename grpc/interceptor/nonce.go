@@ -0,0 +1,48 @@
+package interceptor
+
+import (
+	"sync"
+	"time"
+)
+
+// noncePruneInterval is how often Use drops the nonces that expired.
+const noncePruneInterval = time.Minute
+
+// nonceSet remembers the nonces of accepted calls until their timestamp
+// leaves the signing window.
+type nonceSet struct {
+	mu        sync.Mutex
+	seen      map[string]time.Time
+	lastPrune time.Time
+}
+
+func newNonceSet() *nonceSet {
+	return &nonceSet{
+		seen:      make(map[string]time.Time),
+		lastPrune: time.Now(),
+	}
+}
+
+// Use records nonce until expires and reports whether it was unused.
+func (s *nonceSet) Use(nonce string, expires time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastPrune) >= noncePruneInterval {
+		for seen, until := range s.seen {
+			if now.After(until) {
+				delete(s.seen, seen)
+			}
+		}
+		s.lastPrune = now
+	}
+
+	if until, ok := s.seen[nonce]; ok && !now.After(until) {
+		return false
+	}
+
+	s.seen[nonce] = expires
+
+	return true
+}
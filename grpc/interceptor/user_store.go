@@ -0,0 +1,180 @@
+package interceptor
+
+import (
+	"book/genproto/auth_service"
+	"book/grpc/client"
+
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrUserNotFound = errors.New("user not found")
+
+// UserStoreI resolves the caller behind a request key.
+type UserStoreI interface {
+	GetByKey(ctx context.Context, key string) (*auth_service.User, error)
+}
+
+type MemoryUserStore struct {
+	mu    sync.RWMutex
+	users map[string]*auth_service.User
+}
+
+// NewMemoryUserStore keeps users in memory, keyed by their request key.
+func NewMemoryUserStore(users ...*auth_service.User) *MemoryUserStore {
+	store := &MemoryUserStore{
+		users: make(map[string]*auth_service.User, len(users)),
+	}
+
+	for _, user := range users {
+		store.Add(user)
+	}
+
+	return store
+}
+
+func (s *MemoryUserStore) Add(user *auth_service.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.users[user.GetKey()] = user
+}
+
+func (s *MemoryUserStore) GetByKey(ctx context.Context, key string) (*auth_service.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, ok := s.users[key]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+// maxCachedUsers bounds the cache, which callers fill with keys of their
+// choosing.
+const maxCachedUsers = 10000
+
+// cachedUser is a confirmed user, or a nil user for a key auth_service does
+// not know.
+type cachedUser struct {
+	user    *auth_service.User
+	expires time.Time
+}
+
+type authUserStore struct {
+	services client.ServiceManagerI
+	ttl      time.Duration
+
+	mu    sync.Mutex
+	users map[string]cachedUser
+}
+
+// NewAuthUserStore looks callers up by key in auth_service and confirms them
+// with UserService.CheckUser before they are trusted. This is the only place
+// CheckUser is called: callers are validated once here, in the interceptor,
+// so BookService handlers never call auth_service themselves. Both confirmed
+// and unknown keys are cached for ttl, so most calls, and repeated calls with
+// a bad key, make no auth_service round trip.
+func NewAuthUserStore(services client.ServiceManagerI, ttl time.Duration) UserStoreI {
+	return &authUserStore{
+		services: services,
+		ttl:      ttl,
+		users:    make(map[string]cachedUser),
+	}
+}
+
+func (s *authUserStore) GetByKey(ctx context.Context, key string) (*auth_service.User, error) {
+	if user, ok := s.cached(key); ok {
+		if user == nil {
+			return nil, ErrUserNotFound
+		}
+		return user, nil
+	}
+
+	user, err := s.lookup(ctx, key)
+	if errors.Is(err, ErrUserNotFound) {
+		s.cache(key, nil)
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache(key, user)
+
+	return user, nil
+}
+
+// lookup fetches the user with the given key and checks it is registered.
+func (s *authUserStore) lookup(ctx context.Context, key string) (*auth_service.User, error) {
+	resp, err := s.services.UserService().GetUserByKey(ctx, &auth_service.GetByKey{Key: key})
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user := resp.GetData()
+	if !resp.GetIsOk() || user == nil || user.GetKey() != key {
+		return nil, ErrUserNotFound
+	}
+
+	check, err := s.services.UserService().CheckUser(ctx, &auth_service.CheckUserRequest{
+		Name:   user.GetName(),
+		Secret: user.GetSecret(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !check.GetExists() || !check.GetRegistered() {
+		return nil, ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func (s *authUserStore) cached(key string) (*auth_service.User, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.users[key]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(entry.expires) {
+		delete(s.users, key)
+		return nil, false
+	}
+
+	return entry.user, true
+}
+
+// cache stores user, nil for an unknown key, dropping expired entries when
+// the cache is full and skipping the entry when it still is.
+func (s *authUserStore) cache(key string, user *auth_service.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if len(s.users) >= maxCachedUsers {
+		for cachedKey, entry := range s.users {
+			if now.After(entry.expires) {
+				delete(s.users, cachedKey)
+			}
+		}
+		if len(s.users) >= maxCachedUsers {
+			return
+		}
+	}
+
+	s.users[key] = cachedUser{user: user, expires: now.Add(s.ttl)}
+}
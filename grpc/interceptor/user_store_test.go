@@ -0,0 +1,150 @@
+package interceptor
+
+import (
+	"book/config"
+	"book/genproto/auth_service"
+	"book/grpc/client"
+
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeUserService serves users by key and counts the calls it gets.
+type fakeUserService struct {
+	auth_service.UnimplementedUserServiceServer
+
+	users      []*auth_service.User
+	unchecked  string // a key CheckUser does not confirm
+	keyCalls   int32
+	checkCalls int32
+}
+
+func (f *fakeUserService) GetUserByKey(ctx context.Context, req *auth_service.GetByKey) (*auth_service.OneUserResponse, error) {
+	atomic.AddInt32(&f.keyCalls, 1)
+
+	for _, user := range f.users {
+		if user.GetKey() == req.GetKey() {
+			return &auth_service.OneUserResponse{Data: user, IsOk: true}, nil
+		}
+	}
+
+	return nil, status.Error(codes.NotFound, "user not found")
+}
+
+func (f *fakeUserService) CheckUser(ctx context.Context, req *auth_service.CheckUserRequest) (*auth_service.CheckUserResponse, error) {
+	atomic.AddInt32(&f.checkCalls, 1)
+
+	for _, user := range f.users {
+		if user.GetName() == req.GetName() && user.GetSecret() == req.GetSecret() && user.GetKey() != f.unchecked {
+			return &auth_service.CheckUserResponse{Exists: true, Registered: true}, nil
+		}
+	}
+
+	return &auth_service.CheckUserResponse{}, nil
+}
+
+func newFakeAuthService(t *testing.T, fake *fakeUserService) client.ServiceManagerI {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	auth_service.RegisterUserServiceServer(srv, fake)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	services, err := client.NewGrpcClients(config.Config{AuthServiceHost: "bufconn"}, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}))
+	if err != nil {
+		t.Fatalf("NewGrpcClients: %v", err)
+	}
+	t.Cleanup(func() { services.Close() })
+
+	return services
+}
+
+func TestAuthUserStoreGetByKey(t *testing.T) {
+	fake := &fakeUserService{
+		users: []*auth_service.User{
+			{Id: 1, Name: "reader", Key: "key", Secret: "secret"},
+			{Id: 2, Name: "left", Key: "left-key", Secret: "secret"},
+		},
+		unchecked: "left-key",
+	}
+	store := NewAuthUserStore(newFakeAuthService(t, fake), time.Minute)
+
+	user, err := store.GetByKey(context.Background(), "key")
+	if err != nil {
+		t.Fatalf("GetByKey: %v", err)
+	}
+	if user.GetId() != 1 {
+		t.Fatalf("id = %d, want 1", user.GetId())
+	}
+
+	for _, key := range []string{"missing-key", "left-key"} {
+		if _, err := store.GetByKey(context.Background(), key); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("GetByKey(%q): err = %v, want ErrUserNotFound", key, err)
+		}
+	}
+}
+
+func TestAuthUserStoreCache(t *testing.T) {
+	fake := &fakeUserService{
+		users: []*auth_service.User{{Id: 1, Name: "reader", Key: "key", Secret: "secret"}},
+	}
+	store := NewAuthUserStore(newFakeAuthService(t, fake), time.Minute)
+
+	for i := 0; i < 3; i++ {
+		if _, err := store.GetByKey(context.Background(), "key"); err != nil {
+			t.Fatalf("GetByKey: %v", err)
+		}
+		if _, err := store.GetByKey(context.Background(), "missing-key"); !errors.Is(err, ErrUserNotFound) {
+			t.Fatalf("GetByKey: err = %v, want ErrUserNotFound", err)
+		}
+	}
+
+	if fake.keyCalls != 2 || fake.checkCalls != 1 {
+		t.Fatalf("auth_service calls = %d by key, %d check, want 2 and 1", fake.keyCalls, fake.checkCalls)
+	}
+
+	expired := NewAuthUserStore(newFakeAuthService(t, fake), -time.Second)
+	for i := 0; i < 2; i++ {
+		if _, err := expired.GetByKey(context.Background(), "key"); err != nil {
+			t.Fatalf("GetByKey: %v", err)
+		}
+	}
+
+	if fake.checkCalls != 3 {
+		t.Fatalf("check calls = %d, want 3 once the cache expires", fake.checkCalls)
+	}
+}
+
+func TestAuthUserStoreCacheIsBounded(t *testing.T) {
+	store := NewAuthUserStore(nil, time.Minute).(*authUserStore)
+
+	for i := 0; i < maxCachedUsers+10; i++ {
+		store.cache(strconv.Itoa(i), nil)
+	}
+	if len(store.users) != maxCachedUsers {
+		t.Fatalf("cache holds %d keys, want %d", len(store.users), maxCachedUsers)
+	}
+
+	for key, entry := range store.users {
+		entry.expires = time.Now().Add(-time.Second)
+		store.users[key] = entry
+	}
+	store.cache("key", nil)
+	if len(store.users) != 1 {
+		t.Fatalf("cache holds %d keys after expiry, want 1", len(store.users))
+	}
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/pkg/logger"
+
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) GetAuthorList(ctx context.Context, req *book_service.AuthorListRequest) (*book_service.AuthorListResponse, error) {
+	i.log.Info("---GetAuthorList------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!GetAuthorList->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Author().GetAll(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!GetAuthorList->Author->GetAll--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
@@ -5,10 +5,15 @@ import (
 	"book/genproto/book_service"
 	"book/grpc/client"
 	"book/models"
+	"book/pkg/helper"
+	"book/pkg/isbn"
 	"book/pkg/logger"
+	"book/pkg/metadata"
 	"book/storage"
 
 	"context"
+	"errors"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,38 +24,78 @@ type BookService struct {
 	log      logger.LoggerI
 	strg     storage.StorageI
 	services client.ServiceManagerI
+	provider metadata.Provider
 	book_service.UnimplementedBookServiceServer
 }
 
-func NewBookService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *BookService {
+func NewBookService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI, provider metadata.Provider) *BookService {
 	return &BookService{
 		cfg:      cfg,
 		log:      log,
 		strg:     strg,
 		services: srvs,
+		provider: provider,
 	}
 }
 
 func (i *BookService) Create(ctx context.Context, req *book_service.CreateBook) (*book_service.OneBookResponse, error) {
 	i.log.Info("---CreateBook------>", logger.Any("req", req))
 
-	bookpk, err := i.strg.Book().Create(ctx, req)
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!CreateBook->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	canonical, err := isbn.Canonical(req.Isbn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %q", err.Error(), req.Isbn)
+	}
+
+	if !models.IsValidOwnership(req.GetOwnership()) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown ownership %d", req.GetOwnership())
+	}
+	if err := validateDate(req.GetReturnBy()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid return_by: %v", err)
+	}
+	if req.GetPriority() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "priority must not be negative")
+	}
+	if req.GetSeriesPosition() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "series_position must not be negative")
+	}
+
+	book, err := i.resolveBook(ctx, canonical, req)
+	if err != nil {
+		return nil, err
+	}
+	book.Ownership = req.Ownership
+	switch req.Ownership {
+	case book_service.Ownership_BORROWED:
+		book.BorrowedFrom = req.BorrowedFrom
+		book.ReturnBy = req.ReturnBy
+	case book_service.Ownership_WISHLIST:
+		book.Priority = req.Priority
+		book.WhereToBuy = req.WhereToBuy
+	}
+
+	bookpk, err := i.strg.Book().Create(ctx, userID, book)
+	if errors.Is(err, storage.ErrAlreadyExists) {
+		return nil, status.Errorf(codes.AlreadyExists, "Book with ISBN %s is already on the shelf", canonical)
+	}
 	if err != nil {
 		i.log.Error("!!!CreateBook->Book->Create--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	respons, err := i.strg.Book().GetByPKey(ctx, bookpk)
+	respons, err := i.strg.Book().GetByPKey(ctx, userID, bookpk)
 	if err != nil {
 		i.log.Error("!!!GetBookByID->Book->Get--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	response := &book_service.OneBookResponse{
-		Data: &book_service.BookData{
-			Book:   respons,
-			Status: respons.Status,
-		},
+		Data:    newBookData(respons),
 		IsOk:    true,
 		Message: "ok",
 	}
@@ -62,19 +107,33 @@ func (i *BookService) GetByID(ctx context.Context, req *book_service.BookPK) (re
 
 	i.log.Info("---GetBookByID------>", logger.Any("req", req))
 
-	resp, err = i.strg.Book().GetByPKey(ctx, req)
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!GetBookByID->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err = i.strg.Book().GetByPKey(ctx, userID, req)
 	if err != nil {
 		i.log.Error("!!!GetBookByID->Book->Get--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	i.estimateFinish(ctx, userID, resp)
+
 	return
 }
 
 func (i *BookService) GetBookByTitle(ctx context.Context, req *book_service.BookByTitle) (*book_service.BookResponseByItem, error) {
 	i.log.Info("---GetBookByTitle------>", logger.Any("req", req))
 
-	book, err := i.strg.Book().GetBookByTitle(ctx, req)
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!GetBookByTitle->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	book, err := i.strg.Book().GetBookByTitle(ctx, userID, req)
 	if err != nil {
 		i.log.Error("!!!GetBookByTitle->Book->Get--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -92,7 +151,13 @@ func (i *BookService) GetBookByTitle(ctx context.Context, req *book_service.Book
 func (i *BookService) GetList(ctx context.Context, req *book_service.BookListRequest) (*book_service.BookResponse, error) {
 	i.log.Info("---GetBooks------>", logger.Any("req", req))
 
-	resp, err := i.strg.Book().GetAll(ctx, req)
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!GetBooks->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Book().GetAll(ctx, userID, req)
 	if err != nil {
 		i.log.Error("!!!GetBooks->Book->Get--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -100,17 +165,16 @@ func (i *BookService) GetList(ctx context.Context, req *book_service.BookListReq
 
 	bookDataList := make([]*book_service.BookData, 0, len(resp.Books))
 	for _, book := range resp.Books {
-		bookData := &book_service.BookData{
-			Book:   book,
-			Status: book.Status,
-		}
-		bookDataList = append(bookDataList, bookData)
+		bookDataList = append(bookDataList, newBookData(book))
 	}
 
 	response := &book_service.BookResponse{
-		Data:    bookDataList,
-		IsOk:    true,
-		Message: "ok",
+		Data:          bookDataList,
+		IsOk:          true,
+		Message:       "ok",
+		AverageRating: resp.AverageRating,
+		Count:         resp.Count,
+		OwnedCount:    resp.OwnedCount,
 	}
 
 	return response, nil
@@ -120,8 +184,26 @@ func (i *BookService) Update(ctx context.Context, req *book_service.UpdateBook)
 
 	i.log.Info("---UpdateBook------>", logger.Any("req", req))
 
-	rowsAffected, err := i.strg.Book().Update(ctx, req)
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!UpdateBook->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	for _, author := range req.GetAuthors() {
+		if author.GetRole() != "" && !models.IsValidAuthorRole(author.GetRole()) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown author role %q", author.GetRole())
+		}
+	}
+
+	if !models.IsValidStatus(req.GetStatus()) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown status %d", req.GetStatus())
+	}
 
+	rowsAffected, err := i.strg.Book().Update(ctx, userID, req)
+	if errors.Is(err, storage.ErrInvalidTransition) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		i.log.Error("!!!UpdateBook--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -131,7 +213,7 @@ func (i *BookService) Update(ctx context.Context, req *book_service.UpdateBook)
 		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
 	}
 
-	resp, err = i.strg.Book().GetByPKey(ctx, &book_service.BookPK{Id: req.Id})
+	resp, err = i.strg.Book().GetByPKey(ctx, userID, &book_service.BookPK{Id: req.Id})
 	if err != nil {
 		i.log.Error("!!!GetBook->Book->Get--->", logger.Error(err))
 		return nil, status.Error(codes.NotFound, err.Error())
@@ -144,12 +226,29 @@ func (i *BookService) UpdatePatch(ctx context.Context, req *book_service.UpdateP
 
 	i.log.Info("---UpdatePatchBook------>", logger.Any("req", req))
 
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!UpdatePatchBook->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if req.GetUpdpatch() == nil {
+		return nil, status.Error(codes.InvalidArgument, "updpatch is required")
+	}
+
+	if !models.IsValidStatus(req.GetUpdpatch().GetStatus()) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown status %d", req.GetUpdpatch().GetStatus())
+	}
+
 	updatePatchModel := models.UpdatePatchRequest{
 		Id:       req.GetId(),
 		Updpatch: *req.GetUpdpatch(),
 	}
 
-	rowsAffected, err := i.strg.Book().UpdatePatch(ctx, &updatePatchModel)
+	rowsAffected, err := i.strg.Book().UpdatePatch(ctx, userID, &updatePatchModel)
+	if errors.Is(err, storage.ErrInvalidTransition) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		i.log.Error("!!!UpdatePatchBook--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -159,17 +258,14 @@ func (i *BookService) UpdatePatch(ctx context.Context, req *book_service.UpdateP
 		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
 	}
 
-	respons, err := i.strg.Book().GetByPKey(ctx, &book_service.BookPK{Id: req.Id})
+	respons, err := i.strg.Book().GetByPKey(ctx, userID, &book_service.BookPK{Id: req.Id})
 	if err != nil {
 		i.log.Error("!!!GetBookByID->Book->Get--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	resp = &book_service.OneBookResponse{
-		Data: &book_service.BookData{
-			Book:   respons,
-			Status: respons.Status,
-		},
+		Data:    newBookData(respons),
 		IsOk:    true,
 		Message: "ok",
 	}
@@ -177,12 +273,21 @@ func (i *BookService) UpdatePatch(ctx context.Context, req *book_service.UpdateP
 	return
 }
 
-func (i *BookService) Delete(ctx context.Context, req *book_service.BookPK) (resp *book_service.BookResponse, err error) {
+func (i *BookService) Delete(ctx context.Context, req *book_service.DeleteBookRequest) (resp *book_service.BookResponse, err error) {
 
 	i.log.Info("---DeleteBook------>", logger.Any("req", req))
 
-	err = i.strg.Book().Delete(ctx, req)
+	userID, err := helper.GetUserIDFromContext(ctx)
 	if err != nil {
+		i.log.Error("!!!DeleteBook->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	err = i.strg.Book().Delete(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrLent):
+		return nil, status.Error(codes.FailedPrecondition, "book is lent out, return it first or delete with force")
+	case err != nil:
 		i.log.Error("!!!DeleteBook->Book->Get--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -195,3 +300,120 @@ func (i *BookService) Delete(ctx context.Context, req *book_service.BookPK) (res
 
 	return response, nil
 }
+
+func (i *BookService) GetStatusHistory(ctx context.Context, req *book_service.BookPK) (*book_service.StatusHistoryResponse, error) {
+	i.log.Info("---GetStatusHistory------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!GetStatusHistory->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if _, err := i.strg.Book().GetByPKey(ctx, userID, req); err != nil {
+		i.log.Error("!!!GetStatusHistory->Book->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	resp, err := i.strg.Book().GetStatusHistory(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!GetStatusHistory->Book->GetStatusHistory--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+// resolveBook fills a new book from the metadata providers, falling back to
+// the fields the caller sent when the lookup misses or is skipped.
+func (i *BookService) resolveBook(ctx context.Context, canonical string, req *book_service.CreateBook) (*book_service.Book, error) {
+	manual := &book_service.Book{
+		Isbn:      canonical,
+		Title:     req.GetTitle(),
+		Cover:     req.GetCover(),
+		Author:    req.GetAuthor(),
+		Published: req.GetPublished(),
+		Pages:     req.GetPages(),
+		Source:    models.BookSourceManual,
+		Authors:   req.GetAuthors(),
+
+		SeriesName:     strings.TrimSpace(req.GetSeries()),
+		SeriesPosition: req.GetSeriesPosition(),
+	}
+	if len(manual.Authors) == 0 && manual.Author != "" {
+		manual.Authors = []*book_service.BookAuthor{{Name: manual.Author, Role: models.AuthorRoleAuthor}}
+	}
+	if len(manual.Authors) > 0 {
+		manual.Author = manual.Authors[0].GetName()
+	}
+	for _, author := range manual.Authors {
+		if author.GetRole() != "" && !models.IsValidAuthorRole(author.GetRole()) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown author role %q", author.GetRole())
+		}
+	}
+
+	if req.GetSkipLookup() {
+		if manual.Title == "" {
+			return nil, status.Error(codes.InvalidArgument, "title is required when the lookup is skipped")
+		}
+		return manual, nil
+	}
+
+	bookInfo, err := i.provider.GetByISBN(ctx, canonical)
+	if errors.Is(err, metadata.ErrNotFound) {
+		if manual.Title == "" {
+			return nil, status.Errorf(codes.NotFound, "Book with ISBN %s not found", canonical)
+		}
+		return manual, nil
+	}
+	if err != nil {
+		i.log.Error("!!!CreateBook->Metadata->GetByISBN--->", logger.Error(err))
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	book := &book_service.Book{
+		Isbn:      canonical,
+		Title:     bookInfo.Title,
+		Cover:     bookInfo.Cover,
+		Published: bookInfo.Published,
+		Pages:     bookInfo.Pages,
+		Source:    models.BookSourceProvider,
+
+		FieldSources: bookInfo.Sources,
+
+		SeriesName:     bookInfo.Series,
+		SeriesPosition: float32(bookInfo.SeriesPosition),
+
+		WorkKey: bookInfo.WorkKey,
+	}
+	if book.WorkKey != "" && book.FieldSources["work"] == "" {
+		if book.FieldSources == nil {
+			book.FieldSources = map[string]string{}
+		}
+		book.FieldSources["work"] = i.provider.Name()
+	}
+	if book.SeriesName == "" {
+		book.SeriesName, book.SeriesPosition = manual.SeriesName, manual.SeriesPosition
+	}
+	if len(bookInfo.Authors) > 0 {
+		book.Author = bookInfo.Authors[0]
+	}
+	for position, name := range bookInfo.Authors {
+		book.Authors = append(book.Authors, &book_service.BookAuthor{
+			Name:     name,
+			Role:     models.AuthorRoleAuthor,
+			Position: int32(position),
+		})
+	}
+
+	return book, nil
+}
+
+func newBookData(book *book_service.Book) *book_service.BookData {
+	return &book_service.BookData{
+		Book:            book,
+		Status:          book.GetStatus(),
+		CurrentPage:     book.GetCurrentPage(),
+		PercentComplete: book.GetPercentComplete(),
+	}
+}
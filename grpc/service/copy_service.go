@@ -0,0 +1,152 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/models"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) AddCopy(ctx context.Context, req *book_service.CreateCopyRequest) (*book_service.Copy, error) {
+	i.log.Info("---AddCopy------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!AddCopy->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req.Condition = strings.ToLower(strings.TrimSpace(req.GetCondition()))
+	if req.Condition == "" {
+		req.Condition = models.CopyConditionGood
+	}
+	req.Notes = strings.TrimSpace(req.GetNotes())
+	if err := validateCopy(req.Condition, req.AcquiredAt); err != nil {
+		return nil, err
+	}
+
+	resp, err := i.strg.Copy().Create(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "book or location not found")
+	case errors.Is(err, storage.ErrNotOwned):
+		return nil, status.Error(codes.FailedPrecondition, "only owned books have copies")
+	case err != nil:
+		i.log.Error("!!!AddCopy->Copy->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) UpdateCopy(ctx context.Context, req *book_service.UpdateCopyRequest) (*book_service.Copy, error) {
+	i.log.Info("---UpdateCopy------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!UpdateCopy->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req.Condition = strings.ToLower(strings.TrimSpace(req.GetCondition()))
+	if req.Condition == "" {
+		req.Condition = models.CopyConditionGood
+	}
+	req.Notes = strings.TrimSpace(req.GetNotes())
+	if err := validateCopy(req.Condition, req.AcquiredAt); err != nil {
+		return nil, err
+	}
+
+	resp, err := i.strg.Copy().Update(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "copy not found")
+	case err != nil:
+		i.log.Error("!!!UpdateCopy->Copy->Update--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) DeleteCopy(ctx context.Context, req *book_service.CopyPK) (*empty.Empty, error) {
+	i.log.Info("---DeleteCopy------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!DeleteCopy->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	err = i.strg.Copy().Delete(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "copy not found")
+	case errors.Is(err, storage.ErrLent):
+		return nil, status.Error(codes.FailedPrecondition, "copy is lent out, return it first")
+	case err != nil:
+		i.log.Error("!!!DeleteCopy->Copy->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *BookService) ListCopies(ctx context.Context, req *book_service.CopyListRequest) (*book_service.CopyListResponse, error) {
+	i.log.Info("---ListCopies------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListCopies->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Copy().GetAll(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListCopies->Copy->GetAll--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) MoveCopy(ctx context.Context, req *book_service.MoveCopyRequest) (*book_service.Copy, error) {
+	i.log.Info("---MoveCopy------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!MoveCopy->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Copy().Move(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "copy or location not found")
+	case err != nil:
+		i.log.Error("!!!MoveCopy->Copy->Move--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func validateCopy(condition, acquiredAt string) error {
+	if !models.IsValidCopyCondition(condition) {
+		return status.Errorf(codes.InvalidArgument, "invalid condition %q", condition)
+	}
+	if err := validateDate(acquiredAt); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid acquired_at: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/models"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) SetGoal(ctx context.Context, req *book_service.SetGoalRequest) (*book_service.Goal, error) {
+	i.log.Info("---SetGoal------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!SetGoal->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if req.GetYear() < 1 || req.GetYear() > 9999 {
+		return nil, status.Error(codes.InvalidArgument, "year is out of range")
+	}
+	if !models.IsValidGoalMetric(req.GetMetric()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid goal metric: %d", req.GetMetric())
+	}
+	if req.GetTarget() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "target must be positive")
+	}
+
+	resp, err := i.strg.Goal().Set(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!SetGoal->Goal->Set--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) ListGoals(ctx context.Context, req *book_service.GoalListRequest) (*book_service.GoalListResponse, error) {
+	i.log.Info("---ListGoals------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListGoals->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Goal().GetAll(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListGoals->Goal->GetAll--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) DeleteGoal(ctx context.Context, req *book_service.GoalPK) (*empty.Empty, error) {
+	i.log.Info("---DeleteGoal------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!DeleteGoal->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	rowsAffected, err := i.strg.Goal().Delete(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!DeleteGoal->Goal->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "goal not found")
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *BookService) GetGoalProgress(ctx context.Context, req *book_service.GoalPK) (*book_service.GoalProgress, error) {
+	i.log.Info("---GetGoalProgress------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!GetGoalProgress->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	goal, err := i.strg.Goal().GetByPKey(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "goal not found")
+	case err != nil:
+		i.log.Error("!!!GetGoalProgress->Goal->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	done, err := i.strg.Goal().GetDone(ctx, userID, goal)
+	if err != nil {
+		i.log.Error("!!!GetGoalProgress->Goal->GetDone--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// years are counted in UTC, like the finished_at of the reads in done
+	now := time.Now().UTC()
+	expected := float64(goal.Target) * models.YearElapsed(goal.Year, now)
+
+	resp := &book_service.GoalProgress{
+		Goal:            goal,
+		Done:            done,
+		Expected:        float32(expected),
+		Difference:      float32(float64(done) - expected),
+		Ahead:           float64(done) >= expected,
+		PercentComplete: float32(float64(done) * 100 / float64(goal.Target)),
+		DaysLeft:        models.YearDaysLeft(goal.Year, now),
+	}
+	if done < goal.Target {
+		resp.Remaining = goal.Target - done
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) LendBook(ctx context.Context, req *book_service.LendBookRequest) (*book_service.Loan, error) {
+	i.log.Info("---LendBook------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!LendBook->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req.Borrower = strings.TrimSpace(req.GetBorrower())
+	if req.Borrower == "" {
+		return nil, status.Error(codes.InvalidArgument, "borrower is required")
+	}
+	if err := validateDate(req.GetLentAt()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid lent_at: %v", err)
+	}
+	if err := validateDate(req.GetDueAt()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid due_at: %v", err)
+	}
+
+	resp, err := i.strg.Loan().Lend(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "book or copy not found")
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return nil, status.Error(codes.FailedPrecondition, "copy is already lent out")
+	case errors.Is(err, storage.ErrNotOwned):
+		return nil, status.Error(codes.FailedPrecondition, "only owned books can be lent")
+	case errors.Is(err, storage.ErrOutOfRange):
+		return nil, status.Error(codes.InvalidArgument, "due_at is before lent_at")
+	case err != nil:
+		i.log.Error("!!!LendBook->Loan->Lend--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) ReturnBook(ctx context.Context, req *book_service.ReturnBookRequest) (*book_service.Loan, error) {
+	i.log.Info("---ReturnBook------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ReturnBook->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := validateTimestamp(req.GetReturnedAt()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid returned_at: %v", err)
+	}
+
+	resp, err := i.strg.Loan().Return(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "no copy of the book is lent out")
+	case errors.Is(err, storage.ErrOutOfRange):
+		return nil, status.Error(codes.InvalidArgument, "returned_at is before lent_at")
+	case err != nil:
+		i.log.Error("!!!ReturnBook->Loan->Return--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) ListLoans(ctx context.Context, req *book_service.LoanListRequest) (*book_service.LoanListResponse, error) {
+	i.log.Info("---ListLoans------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListLoans->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Loan().GetAll(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListLoans->Loan->GetAll--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
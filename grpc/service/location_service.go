@@ -0,0 +1,110 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) CreateLocation(ctx context.Context, req *book_service.CreateLocationRequest) (*book_service.Location, error) {
+	i.log.Info("---CreateLocation------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!CreateLocation->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req.Name = strings.TrimSpace(req.GetName())
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "location name is required")
+	}
+
+	resp, err := i.strg.Location().Create(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return nil, status.Errorf(codes.AlreadyExists, "location %q already exists", req.Name)
+	case err != nil:
+		i.log.Error("!!!CreateLocation->Location->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) UpdateLocation(ctx context.Context, req *book_service.UpdateLocationRequest) (*book_service.Location, error) {
+	i.log.Info("---UpdateLocation------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!UpdateLocation->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req.Name = strings.TrimSpace(req.GetName())
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "location name is required")
+	}
+
+	resp, err := i.strg.Location().Update(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "location not found")
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return nil, status.Errorf(codes.AlreadyExists, "location %q already exists", req.Name)
+	case err != nil:
+		i.log.Error("!!!UpdateLocation->Location->Update--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) DeleteLocation(ctx context.Context, req *book_service.LocationPK) (*empty.Empty, error) {
+	i.log.Info("---DeleteLocation------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!DeleteLocation->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	rowsAffected, err := i.strg.Location().Delete(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!DeleteLocation->Location->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "location not found")
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *BookService) ListLocations(ctx context.Context, req *book_service.LocationListRequest) (*book_service.LocationListResponse, error) {
+	i.log.Info("---ListLocations------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListLocations->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Location().GetAll(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListLocations->Location->GetAll--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"book/config"
+	"book/models"
+	"book/pkg/logger"
+	"book/pkg/metadata"
+	"book/storage"
+
+	"context"
+	"errors"
+	"time"
+)
+
+// defaultMetadataRefreshInterval is used when MetadataRefreshInterval is not
+// a positive duration.
+const defaultMetadataRefreshInterval = time.Hour
+
+// CachedProvider answers ISBN lookups from book_metadata_cache and only asks
+// the wrapped provider when the entry is missing or stale. A stale entry is
+// still served if the provider cannot be reached.
+type CachedProvider struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	provider metadata.Provider
+}
+
+func NewCachedProvider(cfg config.Config, log logger.LoggerI, strg storage.StorageI, provider metadata.Provider) *CachedProvider {
+	return &CachedProvider{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		provider: provider,
+	}
+}
+
+func (c *CachedProvider) Name() string {
+	return c.provider.Name()
+}
+
+func (c *CachedProvider) GetByISBN(ctx context.Context, isbn string) (*metadata.Book, error) {
+	cached, err := c.strg.MetadataCache().Get(ctx, isbn)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		c.log.Error("!!!CachedProvider->MetadataCache->Get--->", logger.Error(err))
+		cached = nil
+	}
+
+	if cached != nil && cached.IsFresh(time.Now()) {
+		return &cached.Book, nil
+	}
+
+	book, err := c.fetch(ctx, isbn)
+	if err != nil {
+		if cached != nil {
+			c.log.Warn("!!!CachedProvider->serving stale entry--->", logger.String("isbn", isbn), logger.Error(err))
+			return &cached.Book, nil
+		}
+		return nil, err
+	}
+
+	return book, nil
+}
+
+// RunRefresher re-fetches stale cache entries every MetadataRefreshInterval
+// until ctx is done.
+func (c *CachedProvider) RunRefresher(ctx context.Context) {
+	if c.cfg.MetadataRefreshInterval <= 0 {
+		c.log.Warn("!!!CachedProvider->invalid refresh interval, using the default--->",
+			logger.String("interval", c.cfg.MetadataRefreshInterval.String()),
+			logger.String("default", defaultMetadataRefreshInterval.String()),
+		)
+	}
+
+	ticker := time.NewTicker(c.refreshInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.refreshStale(ctx)
+		}
+	}
+}
+
+func (c *CachedProvider) refreshStale(ctx context.Context) {
+	isbns, err := c.strg.MetadataCache().GetStale(ctx, c.cfg.MetadataRefreshBatch)
+	if err != nil {
+		c.log.Error("!!!CachedProvider->MetadataCache->GetStale--->", logger.Error(err))
+		return
+	}
+
+	for _, isbn := range isbns {
+		if _, err := c.fetch(ctx, isbn); err != nil {
+			c.log.Warn("!!!CachedProvider->refresh--->", logger.String("isbn", isbn), logger.Error(err))
+
+			// back off so failing entries do not crowd out the rest
+			err = c.strg.MetadataCache().MarkFailed(ctx, isbn, c.refreshInterval(), c.cfg.MetadataCacheTTL)
+			if err != nil {
+				c.log.Error("!!!CachedProvider->MetadataCache->MarkFailed--->", logger.Error(err))
+			}
+		}
+	}
+}
+
+func (c *CachedProvider) refreshInterval() time.Duration {
+	if c.cfg.MetadataRefreshInterval <= 0 {
+		return defaultMetadataRefreshInterval
+	}
+	return c.cfg.MetadataRefreshInterval
+}
+
+func (c *CachedProvider) fetch(ctx context.Context, isbn string) (*metadata.Book, error) {
+	book, err := c.provider.GetByISBN(ctx, isbn)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.strg.MetadataCache().Upsert(ctx, &models.MetadataCache{
+		Isbn: isbn,
+		Book: *book,
+		TTL:  c.cfg.MetadataCacheTTL,
+	})
+	if err != nil {
+		c.log.Error("!!!CachedProvider->MetadataCache->Upsert--->", logger.Error(err))
+	}
+
+	return book, nil
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/models"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) CreateNote(ctx context.Context, req *book_service.CreateNoteRequest) (*book_service.Note, error) {
+	i.log.Info("---CreateNote------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!CreateNote->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req.Text = strings.TrimSpace(req.GetText())
+	if err := validateNote(req.Type, req.Text, req.Page); err != nil {
+		return nil, err
+	}
+
+	resp, err := i.strg.Note().Create(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "book not found")
+	case err != nil:
+		i.log.Error("!!!CreateNote->Note->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) GetNote(ctx context.Context, req *book_service.NotePK) (*book_service.Note, error) {
+	i.log.Info("---GetNote------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!GetNote->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Note().GetByPKey(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "note not found")
+	case err != nil:
+		i.log.Error("!!!GetNote->Note->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) UpdateNote(ctx context.Context, req *book_service.UpdateNoteRequest) (*book_service.Note, error) {
+	i.log.Info("---UpdateNote------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!UpdateNote->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req.Text = strings.TrimSpace(req.GetText())
+	if err := validateNote(req.Type, req.Text, req.Page); err != nil {
+		return nil, err
+	}
+
+	resp, err := i.strg.Note().Update(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "note not found")
+	case err != nil:
+		i.log.Error("!!!UpdateNote->Note->Update--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) DeleteNote(ctx context.Context, req *book_service.NotePK) (*empty.Empty, error) {
+	i.log.Info("---DeleteNote------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!DeleteNote->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	rowsAffected, err := i.strg.Note().Delete(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!DeleteNote->Note->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "note not found")
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *BookService) ListBookNotes(ctx context.Context, req *book_service.BookNotesRequest) (*book_service.NoteListResponse, error) {
+	i.log.Info("---ListBookNotes------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListBookNotes->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if req.GetBookId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "book_id is required")
+	}
+
+	resp, err := i.strg.Note().GetAll(ctx, userID, &book_service.NoteListRequest{
+		BookId: req.BookId,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Types:  req.Types,
+	})
+	if err != nil {
+		i.log.Error("!!!ListBookNotes->Note->GetAll--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) ListNotes(ctx context.Context, req *book_service.NoteListRequest) (*book_service.NoteListResponse, error) {
+	i.log.Info("---ListNotes------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListNotes->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := validateDate(req.GetFrom()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from: %v", err)
+	}
+	if err := validateDate(req.GetTo()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to: %v", err)
+	}
+
+	resp, err := i.strg.Note().GetAll(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListNotes->Note->GetAll--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func validateNote(noteType book_service.NoteType, text string, page int32) error {
+	if !models.IsValidNoteType(noteType) {
+		return status.Errorf(codes.InvalidArgument, "invalid note type: %d", noteType)
+	}
+	if text == "" {
+		return status.Error(codes.InvalidArgument, "note text is required")
+	}
+	if page < 0 {
+		return status.Error(codes.InvalidArgument, "page must not be negative")
+	}
+
+	return nil
+}
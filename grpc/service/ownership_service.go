@@ -0,0 +1,102 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/models"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultDueSoonDays is how far ahead ListDueSoon looks when not asked.
+const defaultDueSoonDays = 7
+
+func (i *BookService) SetOwnership(ctx context.Context, req *book_service.SetOwnershipRequest) (*book_service.Book, error) {
+	i.log.Info("---SetOwnership------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!SetOwnership->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if !models.IsValidOwnership(req.GetOwnership()) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown ownership %d", req.GetOwnership())
+	}
+	if err := validateDate(req.GetReturnBy()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid return_by: %v", err)
+	}
+	if req.GetPriority() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "priority must not be negative")
+	}
+
+	return i.setOwnership(ctx, userID, req)
+}
+
+// MoveToOwned marks a wishlist or borrowed book as ours, dropping the
+// wishlist and borrowing details.
+func (i *BookService) MoveToOwned(ctx context.Context, req *book_service.MoveToOwnedRequest) (*book_service.Book, error) {
+	i.log.Info("---MoveToOwned------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!MoveToOwned->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return i.setOwnership(ctx, userID, &book_service.SetOwnershipRequest{
+		BookId:    req.BookId,
+		Ownership: book_service.Ownership_OWNED,
+	})
+}
+
+func (i *BookService) setOwnership(ctx context.Context, userID int32, req *book_service.SetOwnershipRequest) (*book_service.Book, error) {
+	rowsAffected, err := i.strg.Book().SetOwnership(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrLent):
+		return nil, status.Error(codes.FailedPrecondition, "book is lent out, return it first")
+	case err != nil:
+		i.log.Error("!!!SetOwnership->Book->SetOwnership--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "book not found")
+	}
+
+	book, err := i.strg.Book().GetByPKey(ctx, userID, &book_service.BookPK{Id: req.BookId})
+	if err != nil {
+		i.log.Error("!!!SetOwnership->Book->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return book, nil
+}
+
+func (i *BookService) ListDueSoon(ctx context.Context, req *book_service.DueSoonRequest) (*book_service.BookListResponse, error) {
+	i.log.Info("---ListDueSoon------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListDueSoon->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if req.GetDays() <= 0 {
+		req.Days = defaultDueSoonDays
+	}
+
+	resp, err := i.strg.Book().GetDueSoon(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListDueSoon->Book->GetDueSoon--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) UpdateProgress(ctx context.Context, req *book_service.UpdateProgressRequest) (*book_service.OneBookResponse, error) {
+	i.log.Info("---UpdateProgress------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!UpdateProgress->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	rowsAffected, err := i.strg.Book().UpdateProgress(ctx, userID, req)
+	if errors.Is(err, storage.ErrOutOfRange) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, storage.ErrInvalidTransition) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		i.log.Error("!!!UpdateProgress->Book->UpdateProgress--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "book not found")
+	}
+
+	book, err := i.strg.Book().GetByPKey(ctx, userID, &book_service.BookPK{Id: req.BookId})
+	if err != nil {
+		i.log.Error("!!!UpdateProgress->Book->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &book_service.OneBookResponse{
+		Data:    newBookData(book),
+		IsOk:    true,
+		Message: "ok",
+	}, nil
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) StartReread(ctx context.Context, req *book_service.BookPK) (*book_service.Read, error) {
+	i.log.Info("---StartReread------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!StartReread->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Read().Start(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "book not found")
+	case errors.Is(err, storage.ErrInvalidTransition):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		i.log.Error("!!!StartReread->Read->Start--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) AbandonRead(ctx context.Context, req *book_service.BookPK) (*book_service.Read, error) {
+	i.log.Info("---AbandonRead------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!AbandonRead->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Read().Abandon(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "book not found or not being read")
+	case errors.Is(err, storage.ErrInvalidTransition):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		i.log.Error("!!!AbandonRead->Read->Abandon--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) ListReads(ctx context.Context, req *book_service.ReadListRequest) (*book_service.ReadListResponse, error) {
+	i.log.Info("---ListReads------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListReads->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Read().GetAll(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListReads->Read->GetAll--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+	"math"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) CreateReview(ctx context.Context, req *book_service.CreateReviewRequest) (*book_service.Review, error) {
+	i.log.Info("---CreateReview------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!CreateReview->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if !isValidRating(req.GetRating()) {
+		return nil, status.Errorf(codes.InvalidArgument, "rating must be 0.5 to 5 in half stars, got %v", req.GetRating())
+	}
+
+	resp, err := i.strg.Review().Create(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "book not found")
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return nil, status.Error(codes.AlreadyExists, "the book or another edition of it is already reviewed")
+	case err != nil:
+		i.log.Error("!!!CreateReview->Review->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) UpdateReview(ctx context.Context, req *book_service.UpdateReviewRequest) (*book_service.Review, error) {
+	i.log.Info("---UpdateReview------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!UpdateReview->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if !isValidRating(req.GetRating()) {
+		return nil, status.Errorf(codes.InvalidArgument, "rating must be 0.5 to 5 in half stars, got %v", req.GetRating())
+	}
+
+	resp, err := i.strg.Review().Update(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "review not found")
+	case err != nil:
+		i.log.Error("!!!UpdateReview->Review->Update--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) DeleteReview(ctx context.Context, req *book_service.ReviewPK) (*empty.Empty, error) {
+	i.log.Info("---DeleteReview------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!DeleteReview->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	rowsAffected, err := i.strg.Review().Delete(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!DeleteReview->Review->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "review not found")
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *BookService) ListReviews(ctx context.Context, req *book_service.ReviewListRequest) (*book_service.ReviewListResponse, error) {
+	i.log.Info("---ListReviews------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListReviews->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Review().GetAll(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListReviews->Review->GetAll--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func isValidRating(rating float32) bool {
+	return rating >= 0.5 && rating <= 5 && math.Mod(float64(rating)*2, 1) == 0
+}
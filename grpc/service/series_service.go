@@ -0,0 +1,173 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) CreateSeries(ctx context.Context, req *book_service.CreateSeriesRequest) (*book_service.Series, error) {
+	i.log.Info("---CreateSeries------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!CreateSeries->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req.Name = strings.TrimSpace(req.GetName())
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "series name is required")
+	}
+
+	resp, err := i.strg.Series().Create(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return nil, status.Errorf(codes.AlreadyExists, "series %q already exists", req.Name)
+	case err != nil:
+		i.log.Error("!!!CreateSeries->Series->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) UpdateSeries(ctx context.Context, req *book_service.UpdateSeriesRequest) (*book_service.Series, error) {
+	i.log.Info("---UpdateSeries------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!UpdateSeries->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req.Name = strings.TrimSpace(req.GetName())
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "series name is required")
+	}
+
+	resp, err := i.strg.Series().Update(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "series not found")
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return nil, status.Errorf(codes.AlreadyExists, "series %q already exists", req.Name)
+	case err != nil:
+		i.log.Error("!!!UpdateSeries->Series->Update--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) DeleteSeries(ctx context.Context, req *book_service.SeriesPK) (*empty.Empty, error) {
+	i.log.Info("---DeleteSeries------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!DeleteSeries->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	rowsAffected, err := i.strg.Series().Delete(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!DeleteSeries->Series->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "series not found")
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *BookService) ListSeries(ctx context.Context, req *book_service.SeriesListRequest) (*book_service.SeriesListResponse, error) {
+	i.log.Info("---ListSeries------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListSeries->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Series().GetAll(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListSeries->Series->GetAll--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) SetBookSeries(ctx context.Context, req *book_service.SetBookSeriesRequest) (*book_service.Book, error) {
+	i.log.Info("---SetBookSeries------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!SetBookSeries->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if req.GetPosition() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "position must not be negative")
+	}
+
+	err = i.strg.Series().SetBook(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "book or series not found")
+	case errors.Is(err, storage.ErrOutOfRange):
+		return nil, status.Error(codes.InvalidArgument, "position is out of range")
+	case err != nil:
+		i.log.Error("!!!SetBookSeries->Series->SetBook--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.strg.Book().GetByPKey(ctx, userID, &book_service.BookPK{Id: req.BookId})
+	if err != nil {
+		i.log.Error("!!!SetBookSeries->Book->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) NextInSeries(ctx context.Context, req *book_service.SeriesPK) (*book_service.Book, error) {
+	i.log.Info("---NextInSeries------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!NextInSeries->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	_, err = i.strg.Series().GetByPKey(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "series not found")
+	case err != nil:
+		i.log.Error("!!!NextInSeries->Series->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.strg.Series().GetNext(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "every book in the series is read")
+	case err != nil:
+		i.log.Error("!!!NextInSeries->Series->GetNext--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"book/config"
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) StartSession(ctx context.Context, req *book_service.StartSessionRequest) (*book_service.ReadingSession, error) {
+	i.log.Info("---StartSession------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!StartSession->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := validateTimestamp(req.GetStartedAt()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.strg.Session().Start(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "book not found")
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return nil, status.Error(codes.AlreadyExists, "the book already has an open session")
+	case errors.Is(err, storage.ErrOutOfRange):
+		return nil, status.Error(codes.InvalidArgument, "start_page must not be negative")
+	case err != nil:
+		i.log.Error("!!!StartSession->Session->Start--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) EndSession(ctx context.Context, req *book_service.EndSessionRequest) (*book_service.ReadingSession, error) {
+	i.log.Info("---EndSession------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!EndSession->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := validateTimestamp(req.GetEndedAt()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.strg.Session().End(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "open session not found")
+	case errors.Is(err, storage.ErrOutOfRange):
+		return nil, status.Error(codes.InvalidArgument, "a session cannot end before its start time or start page")
+	case err != nil:
+		i.log.Error("!!!EndSession->Session->End--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the end page of a session is also the reader's latest progress, except
+	// on a finished book, which has no read to record it on
+	_, err = i.strg.Book().UpdateProgress(ctx, userID, &book_service.UpdateProgressRequest{
+		BookId:   resp.BookId,
+		Progress: &book_service.UpdateProgressRequest_Page{Page: resp.EndPage},
+	})
+	if err != nil && !errors.Is(err, storage.ErrInvalidTransition) {
+		i.log.Warn("!!!EndSession->Book->UpdateProgress--->", logger.Error(err))
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) ListSessions(ctx context.Context, req *book_service.SessionListRequest) (*book_service.SessionListResponse, error) {
+	i.log.Info("---ListSessions------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListSessions->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Session().GetAll(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListSessions->Session->GetAll--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pace, err := i.strg.Session().GetPace(ctx, userID)
+	if err != nil {
+		i.log.Error("!!!ListSessions->Session->GetPace--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	resp.PagesPerHour = float32(pace.PagesPerHour)
+
+	return resp, nil
+}
+
+// estimateFinish sets EstimatedFinish on a book that is being read, based on
+// the user's session pace. Failures only cost the estimate.
+func (i *BookService) estimateFinish(ctx context.Context, userID int32, book *book_service.Book) {
+	if book.GetStatus() != book_service.BookStatus_READING || book.GetPages() <= 0 {
+		return
+	}
+
+	pace, err := i.strg.Session().GetPace(ctx, userID)
+	if err != nil {
+		i.log.Warn("!!!EstimateFinish->Session->GetPace--->", logger.Error(err))
+		return
+	}
+
+	finish, ok := pace.EstimateFinish(time.Now(), book.GetPages()-book.GetCurrentPage())
+	if ok {
+		book.EstimatedFinish = finish.Format(config.DateFormat)
+	}
+}
+
+func validateTimestamp(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	_, err := time.Parse(config.DatabaseTimeLayout, value)
+	return err
+}
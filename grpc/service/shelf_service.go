@@ -0,0 +1,228 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) CreateShelf(ctx context.Context, req *book_service.CreateShelfRequest) (*book_service.Shelf, error) {
+	i.log.Info("---CreateShelf------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!CreateShelf->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req.Name = strings.TrimSpace(req.GetName())
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "shelf name is required")
+	}
+
+	resp, err := i.strg.Shelf().Create(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return nil, status.Errorf(codes.AlreadyExists, "shelf %q already exists", req.Name)
+	case err != nil:
+		i.log.Error("!!!CreateShelf->Shelf->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) GetShelf(ctx context.Context, req *book_service.ShelfPK) (*book_service.Shelf, error) {
+	i.log.Info("---GetShelf------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!GetShelf->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Shelf().GetByPKey(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "shelf not found")
+	case err != nil:
+		i.log.Error("!!!GetShelf->Shelf->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) ListShelves(ctx context.Context, req *book_service.ShelfListRequest) (*book_service.ShelfListResponse, error) {
+	i.log.Info("---ListShelves------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListShelves->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Shelf().GetAll(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListShelves->Shelf->GetAll--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) UpdateShelf(ctx context.Context, req *book_service.UpdateShelfRequest) (*book_service.Shelf, error) {
+	i.log.Info("---UpdateShelf------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!UpdateShelf->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req.Name = strings.TrimSpace(req.GetName())
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "shelf name is required")
+	}
+
+	resp, err := i.strg.Shelf().Update(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "shelf not found")
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return nil, status.Errorf(codes.AlreadyExists, "shelf %q already exists", req.Name)
+	case err != nil:
+		i.log.Error("!!!UpdateShelf->Shelf->Update--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) DeleteShelf(ctx context.Context, req *book_service.ShelfPK) (*empty.Empty, error) {
+	i.log.Info("---DeleteShelf------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!DeleteShelf->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	rowsAffected, err := i.strg.Shelf().Delete(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!DeleteShelf->Shelf->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "shelf not found")
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *BookService) AddBookToShelf(ctx context.Context, req *book_service.ShelfBookRequest) (*empty.Empty, error) {
+	i.log.Info("---AddBookToShelf------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!AddBookToShelf->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	err = i.strg.Shelf().AddBook(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "shelf or book not found")
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return nil, status.Error(codes.AlreadyExists, "book is already on the shelf")
+	case err != nil:
+		i.log.Error("!!!AddBookToShelf->Shelf->AddBook--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *BookService) RemoveBookFromShelf(ctx context.Context, req *book_service.ShelfBookRequest) (*empty.Empty, error) {
+	i.log.Info("---RemoveBookFromShelf------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!RemoveBookFromShelf->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	rowsAffected, err := i.strg.Shelf().RemoveBook(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!RemoveBookFromShelf->Shelf->RemoveBook--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "book is not on the shelf")
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *BookService) MoveBookOnShelf(ctx context.Context, req *book_service.MoveShelfBookRequest) (*empty.Empty, error) {
+	i.log.Info("---MoveBookOnShelf------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!MoveBookOnShelf->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if req.GetPosition() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "position must not be negative")
+	}
+
+	err = i.strg.Shelf().MoveBook(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "book is not on the shelf")
+	case err != nil:
+		i.log.Error("!!!MoveBookOnShelf->Shelf->MoveBook--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *BookService) ListShelfBooks(ctx context.Context, req *book_service.ShelfBooksRequest) (*book_service.ShelfBooksResponse, error) {
+	i.log.Info("---ListShelfBooks------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListShelfBooks->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	_, err = i.strg.Shelf().GetByPKey(ctx, userID, &book_service.ShelfPK{Id: req.ShelfId})
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "shelf not found")
+	case err != nil:
+		i.log.Error("!!!ListShelfBooks->Shelf->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.strg.Shelf().GetBooks(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListShelfBooks->Shelf->GetBooks--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
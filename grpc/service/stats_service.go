@@ -0,0 +1,57 @@
+package service
+
+import (
+	"book/config"
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/pkg/logger"
+
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultTopAuthors is how many authors GetStats returns when not asked.
+const defaultTopAuthors = 10
+
+func (i *BookService) GetStats(ctx context.Context, req *book_service.StatsRequest) (*book_service.Stats, error) {
+	i.log.Info("---GetStats------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!GetStats->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := validateDate(req.GetFrom()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from: %v", err)
+	}
+	if err := validateDate(req.GetTo()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to: %v", err)
+	}
+	if req.GetFrom() != "" && req.GetTo() != "" && req.GetTo() < req.GetFrom() {
+		return nil, status.Error(codes.InvalidArgument, "to is before from")
+	}
+	if req.GetTopAuthors() <= 0 {
+		req.TopAuthors = defaultTopAuthors
+	}
+
+	resp, err := i.strg.Stats().Get(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!GetStats->Stats->Get--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+func validateDate(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	_, err := time.Parse(config.DateFormat, value)
+	return err
+}
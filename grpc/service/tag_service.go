@@ -0,0 +1,168 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) CreateTag(ctx context.Context, req *book_service.CreateTagRequest) (*book_service.Tag, error) {
+	i.log.Info("---CreateTag------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!CreateTag->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req.Name = strings.TrimSpace(req.GetName())
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "tag name is required")
+	}
+
+	resp, err := i.strg.Tag().Create(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return nil, status.Errorf(codes.AlreadyExists, "tag %q already exists", req.Name)
+	case err != nil:
+		i.log.Error("!!!CreateTag->Tag->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) RenameTag(ctx context.Context, req *book_service.RenameTagRequest) (*book_service.Tag, error) {
+	i.log.Info("---RenameTag------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!RenameTag->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req.Name = strings.TrimSpace(req.GetName())
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "tag name is required")
+	}
+
+	resp, err := i.strg.Tag().Rename(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "tag not found")
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return nil, status.Errorf(codes.AlreadyExists, "tag %q already exists, merge the tags instead", req.Name)
+	case err != nil:
+		i.log.Error("!!!RenameTag->Tag->Rename--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) MergeTags(ctx context.Context, req *book_service.MergeTagsRequest) (*book_service.Tag, error) {
+	i.log.Info("---MergeTags------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!MergeTags->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if req.GetTargetId() <= 0 || len(req.GetSourceIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "target_id and source_ids are required")
+	}
+
+	err = i.strg.Tag().Merge(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "tag not found")
+	case err != nil:
+		i.log.Error("!!!MergeTags->Tag->Merge--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.strg.Tag().GetByPKey(ctx, userID, &book_service.TagPK{Id: req.TargetId})
+	if err != nil {
+		i.log.Error("!!!MergeTags->Tag->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) DeleteTag(ctx context.Context, req *book_service.TagPK) (*empty.Empty, error) {
+	i.log.Info("---DeleteTag------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!DeleteTag->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	rowsAffected, err := i.strg.Tag().Delete(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!DeleteTag->Tag->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "tag not found")
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *BookService) ListTags(ctx context.Context, req *book_service.TagListRequest) (*book_service.TagListResponse, error) {
+	i.log.Info("---ListTags------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListTags->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Tag().GetAll(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListTags->Tag->GetAll--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) SetBookTags(ctx context.Context, req *book_service.SetBookTagsRequest) (*book_service.Book, error) {
+	i.log.Info("---SetBookTags------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!SetBookTags->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	err = i.strg.Tag().SetBookTags(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "book or tag not found")
+	case err != nil:
+		i.log.Error("!!!SetBookTags->Tag->SetBookTags--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.strg.Book().GetByPKey(ctx, userID, &book_service.BookPK{Id: req.BookId})
+	if err != nil {
+		i.log.Error("!!!SetBookTags->Book->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"book/genproto/book_service"
+	"book/models"
+	"book/pkg/helper"
+	"book/pkg/logger"
+	"book/storage"
+
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *BookService) GetWork(ctx context.Context, req *book_service.WorkPK) (*book_service.Work, error) {
+	i.log.Info("---GetWork------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!GetWork->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Work().GetByPKey(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "work not found")
+	case err != nil:
+		i.log.Error("!!!GetWork->Work->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) ListEditions(ctx context.Context, req *book_service.EditionListRequest) (*book_service.BookListResponse, error) {
+	i.log.Info("---ListEditions------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!ListEditions->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	resp, err := i.strg.Work().GetEditions(ctx, userID, req)
+	if err != nil {
+		i.log.Error("!!!ListEditions->Work->GetEditions--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (i *BookService) SetWorkStatus(ctx context.Context, req *book_service.SetWorkStatusRequest) (*book_service.Work, error) {
+	i.log.Info("---SetWorkStatus------>", logger.Any("req", req))
+
+	userID, err := helper.GetUserIDFromContext(ctx)
+	if err != nil {
+		i.log.Error("!!!SetWorkStatus->GetUserID--->", logger.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if !models.IsValidStatus(req.GetStatus()) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown status %d", req.GetStatus())
+	}
+
+	err = i.strg.Work().SetStatus(ctx, userID, req)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "work not found")
+	case errors.Is(err, storage.ErrInvalidTransition):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		i.log.Error("!!!SetWorkStatus->Work->SetStatus--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.strg.Work().GetByPKey(ctx, userID, &book_service.WorkPK{Id: req.WorkId})
+	if err != nil {
+		i.log.Error("!!!SetWorkStatus->Work->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
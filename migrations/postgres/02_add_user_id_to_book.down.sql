@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "book_user_id_idx";
+-- "book_cover_key" is not restored: shelves of different users share covers,
+-- and picking which of those books to drop is not a rollback's call
+ALTER TABLE "book" DROP COLUMN IF EXISTS "user_id";
@@ -0,0 +1,8 @@
+-- rows created before books had owners are left with user 0
+ALTER TABLE "book" ADD COLUMN IF NOT EXISTS "user_id" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE "book" ALTER COLUMN "user_id" DROP DEFAULT;
+
+-- the same cover can now sit on several users' shelves
+ALTER TABLE "book" DROP CONSTRAINT IF EXISTS "book_cover_key";
+
+CREATE INDEX IF NOT EXISTS "book_user_id_idx" ON "book" ("user_id");
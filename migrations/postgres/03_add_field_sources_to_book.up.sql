@@ -0,0 +1 @@
+ALTER TABLE "book" ADD COLUMN IF NOT EXISTS "field_sources" JSONB NOT NULL DEFAULT '{}';
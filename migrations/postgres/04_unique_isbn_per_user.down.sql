@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "book_user_id_isbn_key";
@@ -0,0 +1,36 @@
+UPDATE "book" SET "isbn" = UPPER(REPLACE(REPLACE("isbn", '-', ''), ' ', ''));
+
+-- ISBN-10 -> ISBN-13 when the mod 11 check digit holds, malformed values are
+-- left as they are
+UPDATE "book" SET "isbn" = '978' || LEFT("isbn", 9) || ((10 - (
+    SELECT SUM(SUBSTRING('978' || LEFT("isbn", 9), i, 1)::INTEGER * CASE WHEN i % 2 = 0 THEN 3 ELSE 1 END)
+    FROM generate_series(1, 12) AS i
+) % 10) % 10)::TEXT
+WHERE "isbn" ~ '^[0-9]{9}[0-9X]$'
+    AND (
+        SELECT SUM((11 - i) * CASE WHEN SUBSTRING("isbn", i, 1) = 'X' THEN 10 ELSE SUBSTRING("isbn", i, 1)::INTEGER END)
+        FROM generate_series(1, 10) AS i
+    ) % 11 = 0;
+
+-- hyphenated and plain copies of the same book now collide. Which one to keep
+-- is the owner's call, so list them and stop instead of dropping any; the
+-- updates above can be rerun once they are merged
+DO $$
+DECLARE
+    duplicates TEXT;
+BEGIN
+    SELECT STRING_AGG(FORMAT('user %s, isbn %s: books %s', "user_id", "isbn", "ids"), '; ')
+    INTO duplicates
+    FROM (
+        SELECT "user_id", "isbn", STRING_AGG("id"::TEXT, ', ' ORDER BY "id") AS "ids"
+        FROM "book"
+        GROUP BY "user_id", "isbn"
+        HAVING COUNT(*) > 1
+    ) d;
+
+    IF duplicates IS NOT NULL THEN
+        RAISE EXCEPTION 'books share an ISBN once canonicalized, merge them first: %', duplicates;
+    END IF;
+END $$;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "book_user_id_isbn_key" ON "book" ("user_id", "isbn");
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS "book_metadata_cache" (
+    "isbn" VARCHAR(13) PRIMARY KEY,
+    -- normalized metadata.Book as returned by the provider chain
+    "data" JSONB NOT NULL,
+    "fetched_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "ttl_seconds" INTEGER NOT NULL,
+    -- failed refreshes back off instead of being retried on every run
+    "failures" INTEGER NOT NULL DEFAULT 0,
+    "retry_at" TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "book_metadata_cache_fetched_at_idx" ON "book_metadata_cache" ("fetched_at");
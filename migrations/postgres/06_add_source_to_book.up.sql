@@ -0,0 +1,2 @@
+ALTER TABLE "book" ADD COLUMN IF NOT EXISTS "source" VARCHAR(20) NOT NULL DEFAULT 'provider'
+    CHECK ("source" IN ('provider', 'manual'));
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS "authors" (
+    "id" SERIAL PRIMARY KEY,
+    "name" VARCHAR(255) NOT NULL UNIQUE,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS "book_authors" (
+    "book_id" INTEGER NOT NULL REFERENCES "book" ("id") ON DELETE CASCADE,
+    "author_id" INTEGER NOT NULL REFERENCES "authors" ("id") ON DELETE CASCADE,
+    "role" VARCHAR(20) NOT NULL DEFAULT 'author' CHECK ("role" IN ('author', 'editor', 'translator')),
+    "position" SMALLINT NOT NULL DEFAULT 0,
+    PRIMARY KEY ("book_id", "author_id", "role")
+);
+
+CREATE INDEX IF NOT EXISTS "book_authors_author_id_idx" ON "book_authors" ("author_id");
+
+INSERT INTO "authors" ("name")
+SELECT DISTINCT "author" FROM "book" WHERE "author" <> ''
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "book_authors" ("book_id", "author_id", "role", "position")
+SELECT b."id", a."id", 'author', 0
+FROM "book" b
+JOIN "authors" a ON a."name" = b."author"
+ON CONFLICT DO NOTHING;
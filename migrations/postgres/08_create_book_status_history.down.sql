@@ -0,0 +1,2 @@
+ALTER TABLE "book" DROP CONSTRAINT IF EXISTS "book_status_check";
+DROP TABLE IF EXISTS "book_status_history";
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS "book_status_history" (
+    "id" SERIAL PRIMARY KEY,
+    "book_id" INTEGER NOT NULL REFERENCES "book" ("id") ON DELETE CASCADE,
+    -- NULL for the entry written when the book was added
+    "from_status" SMALLINT,
+    "to_status" SMALLINT NOT NULL,
+    "changed_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "book_status_history_book_id_idx" ON "book_status_history" ("book_id", "changed_at");
+
+-- statuses outside the enum were never meaningful, start those books over
+UPDATE "book" SET "status" = 0 WHERE "status" NOT IN (0, 1, 2);
+
+ALTER TABLE "book" ADD CONSTRAINT "book_status_check" CHECK ("status" IN (0, 1, 2));
+
+INSERT INTO "book_status_history" ("book_id", "from_status", "to_status", "changed_at")
+SELECT "id", NULL, "status", COALESCE("updated_at", "created_at", CURRENT_TIMESTAMP)
+FROM "book";
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS "book_progress" (
+    "id" SERIAL PRIMARY KEY,
+    "book_id" INTEGER NOT NULL REFERENCES "book" ("id") ON DELETE CASCADE,
+    -- NULL when only a percentage is known and the book has no page count
+    "page" INTEGER CHECK ("page" >= 0),
+    "percent" NUMERIC(5, 2) NOT NULL CHECK ("percent" BETWEEN 0 AND 100),
+    "created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "book_progress_book_id_idx" ON "book_progress" ("book_id", "created_at");
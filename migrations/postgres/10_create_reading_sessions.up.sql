@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS "reading_sessions" (
+    "id" SERIAL PRIMARY KEY,
+    "user_id" INTEGER NOT NULL,
+    "book_id" INTEGER NOT NULL REFERENCES "book" ("id") ON DELETE CASCADE,
+    "started_at" TIMESTAMP NOT NULL,
+    -- NULL while the session is open
+    "ended_at" TIMESTAMP,
+    "start_page" INTEGER NOT NULL CHECK ("start_page" >= 0),
+    "end_page" INTEGER,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "reading_sessions_end_check" CHECK ("ended_at" IS NULL OR ("ended_at" >= "started_at" AND "end_page" >= "start_page"))
+);
+
+CREATE INDEX IF NOT EXISTS "reading_sessions_user_id_idx" ON "reading_sessions" ("user_id", "started_at");
+
+-- a book has at most one open session
+CREATE UNIQUE INDEX IF NOT EXISTS "reading_sessions_open_key" ON "reading_sessions" ("book_id") WHERE "ended_at" IS NULL;
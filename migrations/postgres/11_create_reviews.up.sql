@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "reviews" (
+    "id" SERIAL PRIMARY KEY,
+    "user_id" INTEGER NOT NULL,
+    "book_id" INTEGER NOT NULL REFERENCES "book" ("id") ON DELETE CASCADE,
+    -- half stars from 0.5 to 5
+    "rating" NUMERIC(2, 1) NOT NULL CHECK ("rating" BETWEEN 0.5 AND 5 AND "rating" * 2 = FLOOR("rating" * 2)),
+    "text" TEXT NOT NULL DEFAULT '',
+    "spoiler" BOOLEAN NOT NULL DEFAULT FALSE,
+    "created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP,
+    UNIQUE ("user_id", "book_id")
+);
+
+CREATE INDEX IF NOT EXISTS "reviews_book_id_idx" ON "reviews" ("book_id");
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "tags" (
+    "id" SERIAL PRIMARY KEY,
+    "user_id" INTEGER NOT NULL,
+    "name" VARCHAR(50) NOT NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE ("user_id", "name")
+);
+
+CREATE TABLE IF NOT EXISTS "book_tags" (
+    "tag_id" INTEGER NOT NULL REFERENCES "tags" ("id") ON DELETE CASCADE,
+    "book_id" INTEGER NOT NULL REFERENCES "book" ("id") ON DELETE CASCADE,
+    PRIMARY KEY ("book_id", "tag_id")
+);
+
+CREATE INDEX IF NOT EXISTS "book_tags_tag_id_idx" ON "book_tags" ("tag_id");
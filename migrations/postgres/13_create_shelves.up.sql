@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS "shelves" (
+    "id" SERIAL PRIMARY KEY,
+    "user_id" INTEGER NOT NULL,
+    "name" VARCHAR(100) NOT NULL,
+    "description" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP,
+    UNIQUE ("user_id", "name")
+);
+
+CREATE TABLE IF NOT EXISTS "shelf_books" (
+    "shelf_id" INTEGER NOT NULL REFERENCES "shelves" ("id") ON DELETE CASCADE,
+    "book_id" INTEGER NOT NULL REFERENCES "book" ("id") ON DELETE CASCADE,
+    -- fractional so a move only rewrites the moved row
+    "position" DOUBLE PRECISION NOT NULL,
+    "added_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("shelf_id", "book_id")
+);
+
+CREATE INDEX IF NOT EXISTS "shelf_books_position_idx" ON "shelf_books" ("shelf_id", "position");
+CREATE INDEX IF NOT EXISTS "shelf_books_book_id_idx" ON "shelf_books" ("book_id");
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS "goals" (
+    "id" SERIAL PRIMARY KEY,
+    "user_id" INTEGER NOT NULL,
+    "year" SMALLINT NOT NULL,
+    -- 0 books, 1 pages
+    "metric" SMALLINT NOT NULL CHECK ("metric" IN (0, 1)),
+    "target" INTEGER NOT NULL CHECK ("target" > 0),
+    "created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP,
+    UNIQUE ("user_id", "year", "metric")
+);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "notes" (
+    "id" SERIAL PRIMARY KEY,
+    "user_id" INTEGER NOT NULL,
+    "book_id" INTEGER NOT NULL REFERENCES "book" ("id") ON DELETE CASCADE,
+    -- 0 note, 1 highlight, 2 quote
+    "type" SMALLINT NOT NULL DEFAULT 0 CHECK ("type" IN (0, 1, 2)),
+    "text" TEXT NOT NULL,
+    "page" INTEGER CHECK ("page" > 0),
+    "location" VARCHAR(100) NOT NULL DEFAULT '',
+    "shareable" BOOLEAN NOT NULL DEFAULT FALSE,
+    "created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "notes_user_id_idx" ON "notes" ("user_id", "created_at");
+CREATE INDEX IF NOT EXISTS "notes_book_id_idx" ON "notes" ("book_id");
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "loans" (
+    "id" SERIAL PRIMARY KEY,
+    "user_id" INTEGER NOT NULL,
+    "book_id" INTEGER NOT NULL REFERENCES "book" ("id") ON DELETE CASCADE,
+    "borrower" VARCHAR(255) NOT NULL,
+    "contact" VARCHAR(255) NOT NULL DEFAULT '',
+    "lent_at" DATE NOT NULL DEFAULT CURRENT_DATE,
+    "due_at" DATE CHECK ("due_at" >= "lent_at"),
+    "returned_at" TIMESTAMP CHECK ("returned_at"::DATE >= "lent_at"),
+    "created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- a book can only be out with one borrower at a time
+CREATE UNIQUE INDEX IF NOT EXISTS "loans_active_book_id_idx" ON "loans" ("book_id") WHERE "returned_at" IS NULL;
+CREATE INDEX IF NOT EXISTS "loans_user_id_idx" ON "loans" ("user_id", "lent_at");
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "book_return_by_idx";
+ALTER TABLE "book" DROP CONSTRAINT IF EXISTS "book_ownership_check";
+ALTER TABLE "book" DROP COLUMN IF EXISTS "return_by";
+ALTER TABLE "book" DROP COLUMN IF EXISTS "borrowed_from";
+ALTER TABLE "book" DROP COLUMN IF EXISTS "ownership";
@@ -0,0 +1,8 @@
+-- 0 owned, 1 borrowed
+ALTER TABLE "book" ADD COLUMN IF NOT EXISTS "ownership" SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE "book" ADD COLUMN IF NOT EXISTS "borrowed_from" VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE "book" ADD COLUMN IF NOT EXISTS "return_by" DATE;
+
+ALTER TABLE "book" ADD CONSTRAINT "book_ownership_check" CHECK ("ownership" IN (0, 1));
+
+CREATE INDEX IF NOT EXISTS "book_return_by_idx" ON "book" ("user_id", "return_by") WHERE "ownership" = 1;
@@ -0,0 +1,7 @@
+ALTER TABLE "book" DROP COLUMN IF EXISTS "where_to_buy";
+ALTER TABLE "book" DROP COLUMN IF EXISTS "priority";
+
+-- keep wishlist books as owned ones rather than losing them
+UPDATE "book" SET "ownership" = 0 WHERE "ownership" = 2;
+ALTER TABLE "book" DROP CONSTRAINT IF EXISTS "book_ownership_check";
+ALTER TABLE "book" ADD CONSTRAINT "book_ownership_check" CHECK ("ownership" IN (0, 1));
@@ -0,0 +1,6 @@
+-- 2 wishlist
+ALTER TABLE "book" DROP CONSTRAINT IF EXISTS "book_ownership_check";
+ALTER TABLE "book" ADD CONSTRAINT "book_ownership_check" CHECK ("ownership" IN (0, 1, 2));
+
+ALTER TABLE "book" ADD COLUMN IF NOT EXISTS "priority" INTEGER NOT NULL DEFAULT 0 CHECK ("priority" >= 0);
+ALTER TABLE "book" ADD COLUMN IF NOT EXISTS "where_to_buy" TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "book_series_id_idx";
+ALTER TABLE "book" DROP COLUMN IF EXISTS "series_position";
+ALTER TABLE "book" DROP COLUMN IF EXISTS "series_id";
+DROP TABLE IF EXISTS "series";
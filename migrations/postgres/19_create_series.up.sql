@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "series" (
+    "id" SERIAL PRIMARY KEY,
+    "user_id" INTEGER NOT NULL,
+    "name" VARCHAR(255) NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP,
+    UNIQUE ("user_id", "name")
+);
+
+ALTER TABLE "book" ADD COLUMN IF NOT EXISTS "series_id" INTEGER REFERENCES "series" ("id") ON DELETE SET NULL;
+-- fractional for in-between entries such as 3.5
+ALTER TABLE "book" ADD COLUMN IF NOT EXISTS "series_position" NUMERIC(6, 2) CHECK ("series_position" >= 0);
+
+CREATE INDEX IF NOT EXISTS "book_series_id_idx" ON "book" ("series_id", "series_position");
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "book_work_id_idx";
+ALTER TABLE "book" DROP COLUMN IF EXISTS "work_id";
+DROP TABLE IF EXISTS "works";
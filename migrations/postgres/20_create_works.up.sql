@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "works" (
+    "id" SERIAL PRIMARY KEY,
+    "user_id" INTEGER NOT NULL,
+    "provider" VARCHAR(50) NOT NULL,
+    "key" VARCHAR(100) NOT NULL,
+    "title" VARCHAR(255) NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE ("user_id", "provider", "key")
+);
+
+ALTER TABLE "book" ADD COLUMN IF NOT EXISTS "work_id" INTEGER REFERENCES "works" ("id") ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS "book_work_id_idx" ON "book" ("work_id");
@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS "loans_active_copy_id_idx";
+
+-- only one loan per book can stay open, so return all but the oldest
+UPDATE "loans" l SET "returned_at" = NOW()
+WHERE l."returned_at" IS NULL AND EXISTS (
+    SELECT 1 FROM "loans" o
+    WHERE o."book_id" = l."book_id" AND o."returned_at" IS NULL
+        AND (o."lent_at", o."id") < (l."lent_at", l."id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "loans_active_book_id_idx" ON "loans" ("book_id") WHERE "returned_at" IS NULL;
+ALTER TABLE "loans" DROP COLUMN IF EXISTS "copy_id";
+
+DROP TABLE IF EXISTS "copies";
+DROP TABLE IF EXISTS "locations";
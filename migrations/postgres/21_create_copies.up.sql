@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS "locations" (
+    "id" SERIAL PRIMARY KEY,
+    "user_id" INTEGER NOT NULL,
+    "name" VARCHAR(100) NOT NULL,
+    "description" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP,
+    UNIQUE ("user_id", "name")
+);
+
+CREATE TABLE IF NOT EXISTS "copies" (
+    "id" SERIAL PRIMARY KEY,
+    "user_id" INTEGER NOT NULL,
+    "book_id" INTEGER NOT NULL REFERENCES "book" ("id") ON DELETE CASCADE,
+    "location_id" INTEGER REFERENCES "locations" ("id") ON DELETE SET NULL,
+    "condition" VARCHAR(10) NOT NULL DEFAULT 'good' CHECK ("condition" IN ('new', 'fine', 'good', 'fair', 'poor')),
+    "acquired_at" DATE,
+    "notes" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "copies_book_id_idx" ON "copies" ("book_id");
+CREATE INDEX IF NOT EXISTS "copies_location_id_idx" ON "copies" ("location_id");
+
+-- every book on the shelf starts with one copy
+INSERT INTO "copies" ("user_id", "book_id", "acquired_at")
+SELECT "user_id", "id", "created_at"::DATE FROM "book" WHERE "ownership" = 0;
+
+-- loans move from books to copies
+ALTER TABLE "loans" ADD COLUMN IF NOT EXISTS "copy_id" INTEGER REFERENCES "copies" ("id") ON DELETE SET NULL;
+
+UPDATE "loans" l SET "copy_id" = c."id" FROM "copies" c WHERE c."book_id" = l."book_id";
+
+DROP INDEX IF EXISTS "loans_active_book_id_idx";
+CREATE UNIQUE INDEX IF NOT EXISTS "loans_active_copy_id_idx" ON "loans" ("copy_id") WHERE "returned_at" IS NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE "book_status_history" DROP COLUMN IF EXISTS "dnf";
+ALTER TABLE "book_progress" DROP COLUMN IF EXISTS "read_id";
+
+DROP TABLE IF EXISTS "reads";
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS "reads" (
+    "id" SERIAL PRIMARY KEY,
+    "book_id" INTEGER NOT NULL REFERENCES "book" ("id") ON DELETE CASCADE,
+    "started_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- NULL while the read is in progress
+    "finished_at" TIMESTAMP,
+    "dnf" BOOLEAN NOT NULL DEFAULT FALSE CHECK (NOT "dnf" OR "finished_at" IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS "reads_book_id_idx" ON "reads" ("book_id", "started_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "reads_open_book_id_idx" ON "reads" ("book_id") WHERE "finished_at" IS NULL;
+
+ALTER TABLE "book_progress" ADD COLUMN IF NOT EXISTS "read_id" INTEGER REFERENCES "reads" ("id") ON DELETE CASCADE;
+
+-- marks the changes that gave up a read, so going back to FINISHED is not a finish
+ALTER TABLE "book_status_history" ADD COLUMN IF NOT EXISTS "dnf" BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- the status history so far collapses into one read per started book
+INSERT INTO "reads" ("book_id", "started_at", "finished_at")
+SELECT
+    b."id",
+    COALESCE(MIN(h."changed_at") FILTER (WHERE h."to_status" = 1), b."created_at"),
+    CASE WHEN b."status" = 2 THEN COALESCE(MAX(h."changed_at") FILTER (WHERE h."to_status" = 2), b."updated_at", b."created_at") END
+FROM "book" b
+LEFT JOIN "book_status_history" h ON h."book_id" = b."id"
+WHERE b."status" <> 0
+GROUP BY b."id";
+
+UPDATE "book_progress" p SET "read_id" = r."id" FROM "reads" r WHERE r."book_id" = p."book_id";
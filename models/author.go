@@ -0,0 +1,15 @@
+package models
+
+const (
+	AuthorRoleAuthor     = "author"
+	AuthorRoleEditor     = "editor"
+	AuthorRoleTranslator = "translator"
+)
+
+func IsValidAuthorRole(role string) bool {
+	switch role {
+	case AuthorRoleAuthor, AuthorRoleEditor, AuthorRoleTranslator:
+		return true
+	}
+	return false
+}
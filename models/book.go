@@ -0,0 +1,8 @@
+package models
+
+const (
+	// BookSourceProvider marks books filled from a metadata provider.
+	BookSourceProvider = "provider"
+	// BookSourceManual marks books entered by the user.
+	BookSourceManual = "manual"
+)
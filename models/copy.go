@@ -0,0 +1,17 @@
+package models
+
+const (
+	CopyConditionNew  = "new"
+	CopyConditionFine = "fine"
+	CopyConditionGood = "good"
+	CopyConditionFair = "fair"
+	CopyConditionPoor = "poor"
+)
+
+func IsValidCopyCondition(condition string) bool {
+	switch condition {
+	case CopyConditionNew, CopyConditionFine, CopyConditionGood, CopyConditionFair, CopyConditionPoor:
+		return true
+	}
+	return false
+}
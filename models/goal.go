@@ -0,0 +1,44 @@
+package models
+
+import (
+	"book/genproto/book_service"
+
+	"math"
+	"time"
+)
+
+func IsValidGoalMetric(metric book_service.GoalMetric) bool {
+	_, ok := book_service.GoalMetric_name[int32(metric)]
+	return ok
+}
+
+// YearElapsed returns the share of year that has passed at now: 0 before the
+// year starts and 1 once it is over.
+func YearElapsed(year int32, now time.Time) float64 {
+	start := time.Date(int(year), time.January, 1, 0, 0, 0, 0, now.Location())
+	end := start.AddDate(1, 0, 0)
+
+	switch {
+	case !now.After(start):
+		return 0
+	case !now.Before(end):
+		return 1
+	}
+
+	return float64(now.Sub(start)) / float64(end.Sub(start))
+}
+
+// YearDaysLeft counts the days of year left after now, including today.
+func YearDaysLeft(year int32, now time.Time) int32 {
+	start := time.Date(int(year), time.January, 1, 0, 0, 0, 0, now.Location())
+	end := start.AddDate(1, 0, 0)
+
+	if !now.Before(end) {
+		return 0
+	}
+	if now.Before(start) {
+		now = start
+	}
+
+	return int32(math.Ceil(end.Sub(now).Hours() / 24))
+}
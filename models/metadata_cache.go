@@ -0,0 +1,18 @@
+package models
+
+import (
+	"book/pkg/metadata"
+
+	"time"
+)
+
+type MetadataCache struct {
+	Isbn      string        `json:"isbn"`
+	Book      metadata.Book `json:"book"`
+	FetchedAt time.Time     `json:"fetched_at"`
+	TTL       time.Duration `json:"ttl"`
+}
+
+func (m *MetadataCache) IsFresh(now time.Time) bool {
+	return now.Before(m.FetchedAt.Add(m.TTL))
+}
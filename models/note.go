@@ -0,0 +1,8 @@
+package models
+
+import "book/genproto/book_service"
+
+func IsValidNoteType(noteType book_service.NoteType) bool {
+	_, ok := book_service.NoteType_name[int32(noteType)]
+	return ok
+}
@@ -0,0 +1,8 @@
+package models
+
+import "book/genproto/book_service"
+
+func IsValidOwnership(ownership book_service.Ownership) bool {
+	_, ok := book_service.Ownership_name[int32(ownership)]
+	return ok
+}
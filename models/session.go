@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// ReadingPace summarises a user's finished reading sessions.
+type ReadingPace struct {
+	PagesPerHour float64 `json:"pages_per_hour"`
+	HoursPerDay  float64 `json:"hours_per_day"`
+}
+
+// EstimateFinish projects when the remaining pages will be read at this pace.
+// It returns false when there is not enough history to estimate.
+func (p *ReadingPace) EstimateFinish(now time.Time, remainingPages int32) (time.Time, bool) {
+	if p.PagesPerHour <= 0 || p.HoursPerDay <= 0 || remainingPages <= 0 {
+		return time.Time{}, false
+	}
+
+	days := float64(remainingPages) / p.PagesPerHour / p.HoursPerDay
+
+	return now.Add(time.Duration(days * float64(24*time.Hour))), true
+}
@@ -0,0 +1,24 @@
+package models
+
+import "book/genproto/book_service"
+
+// statusTransitions lists the statuses a book may move to from each status.
+var statusTransitions = map[book_service.BookStatus][]book_service.BookStatus{
+	book_service.BookStatus_NEW:      {book_service.BookStatus_READING},
+	book_service.BookStatus_READING:  {book_service.BookStatus_FINISHED, book_service.BookStatus_NEW},
+	book_service.BookStatus_FINISHED: {book_service.BookStatus_READING},
+}
+
+func IsValidStatus(status book_service.BookStatus) bool {
+	_, ok := book_service.BookStatus_name[int32(status)]
+	return ok
+}
+
+func CanTransitionStatus(from, to book_service.BookStatus) bool {
+	for _, allowed := range statusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
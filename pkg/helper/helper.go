@@ -1,14 +1,17 @@
 package helper
 
 import (
-	"book/genproto/book_service"
+	"book/config"
+	"context"
 	"crypto/rand"
 	"database/sql"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"net/http"
 	"strconv"
 	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
 )
 
 func ReplaceQueryParams(namedQuery string, params map[string]interface{}) (string, []interface{}) {
@@ -68,6 +71,18 @@ func Difference(a, b []int32) []int32 {
 	return diff
 }
 
+func Unique(vals []int32) []int32 {
+	seen := make(map[int32]struct{}, len(vals))
+	var unique []int32
+	for _, x := range vals {
+		if _, found := seen[x]; !found {
+			seen[x] = struct{}{}
+			unique = append(unique, x)
+		}
+	}
+	return unique
+}
+
 func ValMultipleQuery(query string, vals []int32) (string, []interface{}) {
 	params := []interface{}{}
 
@@ -107,6 +122,25 @@ func NewNullString(s string) sql.NullString {
 	}
 }
 
+// NewNullTime parses an RFC3339 value and converts it to UTC, the zone the
+// timestamp columns hold, since pgx stores a time's wall clock as it is. An
+// empty value is NULL.
+func NewNullTime(s string) (sql.NullTime, error) {
+	if len(s) == 0 {
+		return sql.NullTime{}, nil
+	}
+
+	t, err := time.Parse(config.DatabaseTimeLayout, s)
+	if err != nil {
+		return sql.NullTime{}, err
+	}
+
+	return sql.NullTime{
+		Time:  t.UTC(),
+		Valid: true,
+	}, nil
+}
+
 func NewNullBool(s bool) sql.NullBool {
 	if !s {
 		return sql.NullBool{}
@@ -117,52 +151,21 @@ func NewNullBool(s bool) sql.NullBool {
 	}
 }
 
-const apiBaseURL = "https://openlibrary.org/api/books"
-
-func GetBookByISBN(isbn string) (*book_service.Book, error) {
-	url := fmt.Sprintf("%s?bibkeys=ISBN:%s&jscmd=data&format=json", apiBaseURL, isbn)
-
-	response, err := http.Get(url)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-
-	var data map[string]interface{}
-	err = json.NewDecoder(response.Body).Decode(&data)
-	if err != nil {
-		return nil, err
-	}
-
-	apiResponse, exists := data["ISBN:"+isbn].(map[string]interface{})
-	if !exists {
-		return nil, fmt.Errorf("Book with ISBN %s not found", isbn)
-	}
-
-	coverURL := fmt.Sprintf("https://covers.openlibrary.org/b/id/%s-L.jpg", strings.Replace(isbn, "-", "", -1))
-
-	book := &book_service.Book{
-		Isbn:      isbn,
-		Title:     apiResponse["title"].(string),
-		Cover:     coverURL,
-		Author:    "",
-		Published: "",
-		Pages:     0,
-	}
-
-	authors := apiResponse["authors"].([]interface{})
-	if len(authors) > 0 {
-		authorMap := authors[0].(map[string]interface{})
-		book.Author = authorMap["name"].(string)
+func GetUserIDFromContext(ctx context.Context) (int32, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, errors.New(config.ErrUserIDNotFound)
 	}
 
-	if publishDate, ok := apiResponse["publish_date"].(string); ok {
-		book.Published = publishDate
+	values := md.Get(config.UserIDMetadataKey)
+	if len(values) == 0 {
+		return 0, errors.New(config.ErrUserIDNotFound)
 	}
 
-	if numPages, ok := apiResponse["number_of_pages"].(float64); ok {
-		book.Pages = int32(numPages)
+	userID, err := strconv.ParseInt(values[0], 10, 32)
+	if err != nil || userID <= 0 {
+		return 0, fmt.Errorf("invalid user id %q in request metadata", values[0])
 	}
 
-	return book, nil
+	return int32(userID), nil
 }
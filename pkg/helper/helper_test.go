@@ -0,0 +1,26 @@
+package helper
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewNullTime(t *testing.T) {
+	got, err := NewNullTime("2024-03-01T10:30:00+05:00")
+	if err != nil {
+		t.Fatalf("NewNullTime: %v", err)
+	}
+
+	want := time.Date(2024, time.March, 1, 5, 30, 0, 0, time.UTC)
+	if !got.Valid || !got.Time.Equal(want) || got.Time.Location() != time.UTC {
+		t.Errorf("NewNullTime = %v, want %v", got, want)
+	}
+
+	if got, err := NewNullTime(""); err != nil || got.Valid {
+		t.Errorf("NewNullTime(\"\") = %v, %v, want NULL", got, err)
+	}
+
+	if _, err := NewNullTime("2024-03-01 10:30"); err == nil {
+		t.Error("NewNullTime accepted a value that is not RFC3339")
+	}
+}
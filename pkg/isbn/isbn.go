@@ -0,0 +1,91 @@
+package isbn
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid ISBN")
+
+// Clean drops the separators people usually type inside an ISBN.
+func Clean(s string) string {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	return strings.NewReplacer("-", "", " ", "").Replace(s)
+}
+
+// IsValid10 checks the length, characters and checksum of a cleaned ISBN-10.
+func IsValid10(s string) bool {
+	if len(s) != 10 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 10; i++ {
+		var digit int
+		switch {
+		case s[i] >= '0' && s[i] <= '9':
+			digit = int(s[i] - '0')
+		case s[i] == 'X' && i == 9:
+			digit = 10
+		default:
+			return false
+		}
+		sum += digit * (10 - i)
+	}
+
+	return sum%11 == 0
+}
+
+// IsValid13 checks the length, characters and checksum of a cleaned ISBN-13.
+func IsValid13(s string) bool {
+	if len(s) != 13 || !(strings.HasPrefix(s, "978") || strings.HasPrefix(s, "979")) {
+		return false
+	}
+
+	for i := 0; i < 13; i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return checkDigit13(s[:12]) == s[12]
+}
+
+// To13 converts a valid ISBN-10 into its ISBN-13 form.
+func To13(s string) (string, error) {
+	s = Clean(s)
+	if !IsValid10(s) {
+		return "", ErrInvalid
+	}
+
+	prefix := "978" + s[:9]
+	return prefix + string(checkDigit13(prefix)), nil
+}
+
+// Canonical validates an ISBN in any common notation and returns it as a
+// bare ISBN-13.
+func Canonical(s string) (string, error) {
+	s = Clean(s)
+
+	switch {
+	case IsValid13(s):
+		return s, nil
+	case IsValid10(s):
+		return To13(s)
+	}
+
+	return "", ErrInvalid
+}
+
+func checkDigit13(first12 string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		digit := int(first12[i] - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,94 @@
+package isbn
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsValid10(t *testing.T) {
+	tests := []struct {
+		isbn  string
+		valid bool
+	}{
+		{"0306406152", true},
+		{"080442957X", true},
+		{"0140449132", true},
+		{"0306406153", false}, // bad check digit
+		{"030640615", false},  // too short
+		{"03064061522", false},
+		{"X306406152", false}, // X is only allowed as the check digit
+		{"03064O6152", false}, // letter O instead of zero
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValid10(tt.isbn); got != tt.valid {
+			t.Errorf("IsValid10(%q) = %t, want %t", tt.isbn, got, tt.valid)
+		}
+	}
+}
+
+func TestIsValid13(t *testing.T) {
+	tests := []struct {
+		isbn  string
+		valid bool
+	}{
+		{"9780306406157", true},
+		{"9791090636071", true},
+		{"9780306406158", false}, // bad check digit
+		{"9770306406157", false}, // not a 978/979 prefix
+		{"978030640615", false},  // too short
+		{"978030640615X", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValid13(tt.isbn); got != tt.valid {
+			t.Errorf("IsValid13(%q) = %t, want %t", tt.isbn, got, tt.valid)
+		}
+	}
+}
+
+func TestTo13(t *testing.T) {
+	tests := []struct {
+		isbn string
+		want string
+		err  error
+	}{
+		{"0306406152", "9780306406157", nil},
+		{"0-8044-2957-X", "9780804429573", nil},
+		{"080442957x", "9780804429573", nil},
+		{"0140449132", "9780140449136", nil},
+		{"0306406153", "", ErrInvalid},
+		{"9780306406157", "", ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		got, err := To13(tt.isbn)
+		if !errors.Is(err, tt.err) || got != tt.want {
+			t.Errorf("To13(%q) = %q, %v, want %q, %v", tt.isbn, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		isbn string
+		want string
+		err  error
+	}{
+		{"978-0-306-40615-7", "9780306406157", nil},
+		{" 978 0 306 40615 7 ", "9780306406157", nil},
+		{"0-306-40615-2", "9780306406157", nil},
+		{"979-10-90636-07-1", "9791090636071", nil},
+		{"978-0-306-40615-8", "", ErrInvalid},
+		{"not an isbn", "", ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		got, err := Canonical(tt.isbn)
+		if !errors.Is(err, tt.err) || got != tt.want {
+			t.Errorf("Canonical(%q) = %q, %v, want %q, %v", tt.isbn, got, err, tt.want, tt.err)
+		}
+	}
+}
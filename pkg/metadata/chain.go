@@ -0,0 +1,126 @@
+package metadata
+
+import (
+	"book/config"
+
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const ChainName = "chain"
+
+// Chain asks its providers in order and fills the fields the earlier ones
+// left empty from the later ones.
+type Chain struct {
+	providers []Provider
+}
+
+func NewChain(providers ...Provider) *Chain {
+	return &Chain{
+		providers: providers,
+	}
+}
+
+// NewFromConfig builds the provider chain listed in config.MetadataProviders.
+func NewFromConfig(cfg config.Config) (*Chain, error) {
+	client := &http.Client{Timeout: cfg.MetadataTimeout}
+
+	var providers []Provider
+	for _, name := range cfg.MetadataProviders {
+		switch strings.TrimSpace(name) {
+		case OpenLibraryName:
+			providers = append(providers, NewOpenLibrary(client, cfg.OpenLibraryURL))
+		case GoogleBooksName:
+			providers = append(providers, NewGoogleBooks(client, cfg.GoogleBooksURL, cfg.GoogleBooksAPIKey))
+		case "":
+		default:
+			return nil, fmt.Errorf("unknown metadata provider %q", name)
+		}
+	}
+
+	if len(providers) == 0 {
+		return nil, errors.New("no metadata provider is enabled")
+	}
+
+	return NewChain(providers...), nil
+}
+
+func (c *Chain) Name() string {
+	return ChainName
+}
+
+func (c *Chain) GetByISBN(ctx context.Context, isbn string) (*Book, error) {
+	var (
+		merged *Book
+		errs   []error
+	)
+
+	for _, provider := range c.providers {
+		book, err := provider.GetByISBN(ctx, isbn)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", provider.Name(), err))
+			continue
+		}
+
+		if merged == nil {
+			merged = &Book{ISBN: isbn, Sources: map[string]string{}}
+		}
+		merge(merged, book, provider.Name())
+
+		if complete(merged) {
+			break
+		}
+	}
+
+	if merged != nil {
+		return merged, nil
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return nil, ErrNotFound
+}
+
+func merge(dst, src *Book, source string) {
+	if dst.Title == "" && src.Title != "" {
+		dst.Title = src.Title
+		dst.Sources["title"] = source
+	}
+	if len(dst.Authors) == 0 && len(src.Authors) > 0 {
+		dst.Authors = src.Authors
+		dst.Sources["authors"] = source
+	}
+	if dst.Published == "" && src.Published != "" {
+		dst.Published = src.Published
+		dst.Sources["published"] = source
+	}
+	if dst.Pages == 0 && src.Pages > 0 {
+		dst.Pages = src.Pages
+		dst.Sources["pages"] = source
+	}
+	if dst.Cover == "" && src.Cover != "" {
+		dst.Cover = src.Cover
+		dst.Sources["cover"] = source
+	}
+	if dst.Series == "" && src.Series != "" {
+		dst.Series = src.Series
+		dst.SeriesPosition = src.SeriesPosition
+		dst.Sources["series"] = source
+	}
+	if dst.WorkKey == "" && src.WorkKey != "" {
+		dst.WorkKey = src.WorkKey
+		dst.Sources["work"] = source
+	}
+}
+
+func complete(book *Book) bool {
+	return book.Title != "" && len(book.Authors) > 0 && book.Published != "" && book.Pages > 0 && book.Cover != ""
+}
@@ -0,0 +1,136 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// stubProvider answers every lookup with the same book or error and counts
+// how often it was asked.
+type stubProvider struct {
+	name  string
+	book  *Book
+	err   error
+	calls int
+}
+
+func (s *stubProvider) Name() string {
+	return s.name
+}
+
+func (s *stubProvider) GetByISBN(ctx context.Context, isbn string) (*Book, error) {
+	s.calls++
+	return s.book, s.err
+}
+
+func TestChainMergesFields(t *testing.T) {
+	first := &stubProvider{name: "first", book: &Book{
+		Title:   "Guards! Guards!",
+		Authors: []string{"Terry Pratchett"},
+		Series:  "Discworld",
+		// a later provider must not overwrite the position of the series
+		SeriesPosition: 8,
+	}}
+	second := &stubProvider{name: "second", book: &Book{
+		Title:          "Guards Guards",
+		Published:      "1989",
+		Pages:          288,
+		Cover:          "https://covers.example/large.jpg",
+		Series:         "Discworld (Watch)",
+		SeriesPosition: 1,
+		WorkKey:        "W1",
+	}}
+	third := &stubProvider{name: "third", book: &Book{Title: "unused"}}
+
+	book, err := NewChain(first, second, third).GetByISBN(context.Background(), "9780306406157")
+	if err != nil {
+		t.Fatalf("GetByISBN: %v", err)
+	}
+
+	if book.Title != "Guards! Guards!" || book.Pages != 288 || book.Published != "1989" {
+		t.Errorf("book = %+v", book)
+	}
+	if book.Series != "Discworld" || book.SeriesPosition != 8 {
+		t.Errorf("series = %q %v, want Discworld 8", book.Series, book.SeriesPosition)
+	}
+
+	wantSources := map[string]string{
+		"title":     "first",
+		"authors":   "first",
+		"series":    "first",
+		"published": "second",
+		"pages":     "second",
+		"cover":     "second",
+		"work":      "second",
+	}
+	for field, source := range wantSources {
+		if book.Sources[field] != source {
+			t.Errorf("Sources[%q] = %q, want %q", field, book.Sources[field], source)
+		}
+	}
+
+	if third.calls != 0 {
+		t.Errorf("third provider asked %d times after the book was complete", third.calls)
+	}
+}
+
+func TestChainFallsBack(t *testing.T) {
+	down := &stubProvider{name: "down", err: errors.New("connection refused")}
+	missing := &stubProvider{name: "missing", err: ErrNotFound}
+	found := &stubProvider{name: "found", book: &Book{Title: "Guards! Guards!"}}
+
+	book, err := NewChain(down, missing, found).GetByISBN(context.Background(), "9780306406157")
+	if err != nil {
+		t.Fatalf("GetByISBN: %v", err)
+	}
+	if book.Title != "Guards! Guards!" || book.Sources["title"] != "found" {
+		t.Errorf("book = %+v", book)
+	}
+}
+
+func TestChainErrors(t *testing.T) {
+	missing := &stubProvider{name: "missing", err: ErrNotFound}
+	if _, err := NewChain(missing, missing).GetByISBN(context.Background(), "9780306406157"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("err = %v, want ErrNotFound", err)
+	}
+
+	boom := errors.New("boom")
+	down := &stubProvider{name: "down", err: boom}
+	_, err := NewChain(missing, down).GetByISBN(context.Background(), "9780306406157")
+	if !errors.Is(err, boom) || errors.Is(err, ErrNotFound) {
+		t.Errorf("err = %v, want the provider error", err)
+	}
+}
+
+// TestChainOverHTTP runs the real providers against httptest stand-ins, with
+// Open Library missing the pages Google Books has.
+func TestChainOverHTTP(t *testing.T) {
+	openLibrary := newOpenLibraryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/books" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"ISBN:9780306406157": {"title": "Guards! Guards!", "authors": [{"name": "Terry Pratchett"}]}}`))
+	})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(googleBooksVolumesResponse))
+	}))
+	t.Cleanup(srv.Close)
+	googleBooks := NewGoogleBooks(srv.Client(), srv.URL, "")
+
+	book, err := NewChain(openLibrary, googleBooks).GetByISBN(context.Background(), "9780306406157")
+	if err != nil {
+		t.Fatalf("GetByISBN: %v", err)
+	}
+
+	if book.Sources["title"] != OpenLibraryName || book.Sources["pages"] != GoogleBooksName {
+		t.Errorf("sources = %v", book.Sources)
+	}
+	if book.Pages != 416 || book.Published != "2001-02-06" {
+		t.Errorf("book = %+v", book)
+	}
+}
@@ -0,0 +1,107 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	GoogleBooksName    = "googlebooks"
+	GoogleBooksBaseURL = "https://www.googleapis.com"
+)
+
+type GoogleBooks struct {
+	client  *http.Client
+	baseURL string
+	apiKey  string
+}
+
+type googleBooksResponse struct {
+	TotalItems int `json:"totalItems"`
+	Items      []struct {
+		VolumeInfo struct {
+			Title         string   `json:"title"`
+			Authors       []string `json:"authors"`
+			PublishedDate string   `json:"publishedDate"`
+			PageCount     float64  `json:"pageCount"`
+			ImageLinks    struct {
+				Thumbnail string `json:"thumbnail"`
+			} `json:"imageLinks"`
+		} `json:"volumeInfo"`
+	} `json:"items"`
+}
+
+// NewGoogleBooks queries a Google Books compatible volumes API at baseURL.
+// The API key is optional.
+func NewGoogleBooks(client *http.Client, baseURL, apiKey string) *GoogleBooks {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if baseURL == "" {
+		baseURL = GoogleBooksBaseURL
+	}
+
+	return &GoogleBooks{
+		client:  client,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+	}
+}
+
+func (g *GoogleBooks) Name() string {
+	return GoogleBooksName
+}
+
+func (g *GoogleBooks) GetByISBN(ctx context.Context, isbn string) (*Book, error) {
+	query := url.Values{}
+	query.Set("q", "isbn:"+isbn)
+	if g.apiKey != "" {
+		query.Set("key", g.apiKey)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+"/books/v1/volumes?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := g.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("googlebooks: unexpected status %s", response.Status)
+	}
+
+	var data googleBooksResponse
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("googlebooks: %w", err)
+	}
+
+	if len(data.Items) == 0 {
+		return nil, ErrNotFound
+	}
+
+	volume := data.Items[0].VolumeInfo
+
+	book := &Book{
+		ISBN:      isbn,
+		Title:     volume.Title,
+		Published: volume.PublishedDate,
+		Pages:     int32(volume.PageCount),
+		Cover:     strings.Replace(volume.ImageLinks.Thumbnail, "http://", "https://", 1),
+	}
+
+	for _, author := range volume.Authors {
+		if author != "" {
+			book.Authors = append(book.Authors, author)
+		}
+	}
+
+	return book, nil
+}
@@ -0,0 +1,104 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const googleBooksVolumesResponse = `{
+	"totalItems": 1,
+	"items": [{
+		"volumeInfo": {
+			"title": "Guards! Guards!",
+			"authors": ["Terry Pratchett"],
+			"publishedDate": "2001-02-06",
+			"pageCount": 416,
+			"imageLinks": {"thumbnail": "http://books.example/thumb.jpg"}
+		}
+	}]
+}`
+
+func newGoogleBooksServer(t *testing.T, apiKey string, handler http.HandlerFunc) *GoogleBooks {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	return NewGoogleBooks(srv.Client(), srv.URL, apiKey)
+}
+
+func TestGoogleBooksGetByISBN(t *testing.T) {
+	provider := newGoogleBooksServer(t, "api-key", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/books/v1/volumes" {
+			http.NotFound(w, r)
+			return
+		}
+		if got := r.URL.Query().Get("q"); got != "isbn:9780306406157" {
+			t.Errorf("q = %q", got)
+		}
+		if got := r.URL.Query().Get("key"); got != "api-key" {
+			t.Errorf("key = %q", got)
+		}
+		w.Write([]byte(googleBooksVolumesResponse))
+	})
+
+	book, err := provider.GetByISBN(context.Background(), "9780306406157")
+	if err != nil {
+		t.Fatalf("GetByISBN: %v", err)
+	}
+
+	if book.Title != "Guards! Guards!" || book.Published != "2001-02-06" || book.Pages != 416 {
+		t.Errorf("book = %+v", book)
+	}
+	if len(book.Authors) != 1 || book.Authors[0] != "Terry Pratchett" {
+		t.Errorf("authors = %q", book.Authors)
+	}
+	if book.Cover != "https://books.example/thumb.jpg" {
+		t.Errorf("cover = %q, want it upgraded to https", book.Cover)
+	}
+}
+
+func TestGoogleBooksErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		notFound bool
+	}{
+		{
+			name: "no items",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"totalItems": 0}`))
+			},
+			notFound: true,
+		},
+		{
+			name: "server error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "quota exceeded", http.StatusTooManyRequests)
+			},
+		},
+		{
+			name: "unexpected json",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"items": {"volumeInfo": []}}`))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newGoogleBooksServer(t, "", tt.handler)
+
+			book, err := provider.GetByISBN(context.Background(), "9780306406157")
+			if err == nil {
+				t.Fatalf("got %+v, want an error", book)
+			}
+			if errors.Is(err, ErrNotFound) != tt.notFound {
+				t.Fatalf("err = %v, ErrNotFound = %t", err, tt.notFound)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrNotFound = errors.New("book not found")
+
+// Book is the provider independent description of an edition.
+type Book struct {
+	ISBN      string   `json:"isbn"`
+	Title     string   `json:"title"`
+	Authors   []string `json:"authors"`
+	Published string   `json:"published"`
+	Pages     int32    `json:"pages"`
+	Cover     string   `json:"cover"`
+
+	// Series is empty when the provider does not know of one. SeriesPosition
+	// may be fractional, e.g. 3.5 for a novella between books 3 and 4.
+	Series         string  `json:"series,omitempty"`
+	SeriesPosition float64 `json:"series_position,omitempty"`
+
+	// WorkKey identifies the work this edition belongs to at the provider
+	// that filled it, so editions of the same book can be grouped.
+	WorkKey string `json:"work_key,omitempty"`
+
+	// Sources maps each filled field to the provider that supplied it.
+	Sources map[string]string `json:"sources,omitempty"`
+}
+
+// Provider looks books up by ISBN. Implementations return ErrNotFound when
+// the ISBN is unknown to them.
+type Provider interface {
+	Name() string
+	GetByISBN(ctx context.Context, isbn string) (*Book, error)
+}
@@ -0,0 +1,173 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	OpenLibraryName     = "openlibrary"
+	OpenLibraryBaseURL  = "https://openlibrary.org"
+	openLibraryCoverURL = "https://covers.openlibrary.org/b/isbn/%s-L.jpg"
+)
+
+type OpenLibrary struct {
+	client  *http.Client
+	baseURL string
+}
+
+type openLibraryBook struct {
+	Title   string `json:"title"`
+	Authors []struct {
+		Name string `json:"name"`
+	} `json:"authors"`
+	PublishDate   string  `json:"publish_date"`
+	NumberOfPages float64 `json:"number_of_pages"`
+	Cover         struct {
+		Large string `json:"large"`
+	} `json:"cover"`
+}
+
+// openLibraryEdition is the part of the edition record the books API leaves
+// out.
+type openLibraryEdition struct {
+	Series []string `json:"series"`
+	Works  []struct {
+		Key string `json:"key"`
+	} `json:"works"`
+}
+
+// openLibrarySeries splits entries such as "Discworld ; 3", "Discworld -- 3.5"
+// or "A Song of Ice and Fire, book 1" into the name and the position.
+var openLibrarySeries = regexp.MustCompile(`(?i)^(.*?)[\s,;:(#-]*(?:book|vol\.?|volume|no\.?|number|part)?\s*#?\s*(\d+(?:\.\d+)?)\)?$`)
+
+// NewOpenLibrary queries the Open Library books API at baseURL. A nil client
+// falls back to http.DefaultClient.
+func NewOpenLibrary(client *http.Client, baseURL string) *OpenLibrary {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if baseURL == "" {
+		baseURL = OpenLibraryBaseURL
+	}
+
+	return &OpenLibrary{
+		client:  client,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+func (o *OpenLibrary) Name() string {
+	return OpenLibraryName
+}
+
+func (o *OpenLibrary) GetByISBN(ctx context.Context, isbn string) (*Book, error) {
+	bibkey := "ISBN:" + isbn
+
+	query := url.Values{}
+	query.Set("bibkeys", bibkey)
+	query.Set("jscmd", "data")
+	query.Set("format", "json")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.baseURL+"/api/books?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := o.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("openlibrary: unexpected status %s", response.Status)
+	}
+
+	var data map[string]openLibraryBook
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("openlibrary: %w", err)
+	}
+
+	found, ok := data[bibkey]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	book := &Book{
+		ISBN:      isbn,
+		Title:     found.Title,
+		Published: found.PublishDate,
+		Pages:     int32(found.NumberOfPages),
+		Cover:     found.Cover.Large,
+	}
+
+	if book.Cover == "" {
+		book.Cover = fmt.Sprintf(openLibraryCoverURL, isbn)
+	}
+
+	for _, author := range found.Authors {
+		if author.Name != "" {
+			book.Authors = append(book.Authors, author.Name)
+		}
+	}
+
+	// the edition record is optional, a failed lookup leaves the series
+	// and the work empty
+	if edition, err := o.getEdition(ctx, isbn); err == nil {
+		if len(edition.Series) > 0 {
+			book.Series, book.SeriesPosition = parseOpenLibrarySeries(edition.Series[0])
+		}
+		if len(edition.Works) > 0 {
+			book.WorkKey = strings.TrimPrefix(edition.Works[0].Key, "/works/")
+		}
+	}
+
+	return book, nil
+}
+
+func (o *OpenLibrary) getEdition(ctx context.Context, isbn string) (*openLibraryEdition, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.baseURL+"/isbn/"+url.PathEscape(isbn)+".json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := o.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("openlibrary: unexpected status %s", response.Status)
+	}
+
+	var edition openLibraryEdition
+	if err := json.NewDecoder(response.Body).Decode(&edition); err != nil {
+		return nil, fmt.Errorf("openlibrary: %w", err)
+	}
+
+	return &edition, nil
+}
+
+func parseOpenLibrarySeries(value string) (string, float64) {
+	value = strings.TrimSpace(value)
+
+	match := openLibrarySeries.FindStringSubmatch(value)
+	if match == nil || strings.TrimSpace(match[1]) == "" {
+		return value, 0
+	}
+
+	position, err := strconv.ParseFloat(match[2], 64)
+	if err != nil {
+		return value, 0
+	}
+
+	return strings.TrimSpace(match[1]), position
+}
@@ -0,0 +1,178 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const openLibraryBooksResponse = `{
+	"ISBN:9780306406157": {
+		"title": "Guards! Guards!",
+		"authors": [{"name": "Terry Pratchett"}, {"name": ""}],
+		"publish_date": "1989",
+		"number_of_pages": 288,
+		"cover": {"large": "https://covers.example/large.jpg"}
+	}
+}`
+
+const openLibraryEditionResponse = `{
+	"series": ["Discworld ; 8"],
+	"works": [{"key": "/works/OL453936W"}]
+}`
+
+func newOpenLibraryServer(t *testing.T, handler http.HandlerFunc) *OpenLibrary {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	return NewOpenLibrary(srv.Client(), srv.URL)
+}
+
+func TestOpenLibraryGetByISBN(t *testing.T) {
+	provider := newOpenLibraryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/books":
+			if got := r.URL.Query().Get("bibkeys"); got != "ISBN:9780306406157" {
+				t.Errorf("bibkeys = %q", got)
+			}
+			w.Write([]byte(openLibraryBooksResponse))
+		case "/isbn/9780306406157.json":
+			w.Write([]byte(openLibraryEditionResponse))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	book, err := provider.GetByISBN(context.Background(), "9780306406157")
+	if err != nil {
+		t.Fatalf("GetByISBN: %v", err)
+	}
+
+	if book.Title != "Guards! Guards!" || book.Published != "1989" || book.Pages != 288 {
+		t.Errorf("book = %+v", book)
+	}
+	if len(book.Authors) != 1 || book.Authors[0] != "Terry Pratchett" {
+		t.Errorf("authors = %q, want [Terry Pratchett]", book.Authors)
+	}
+	if book.Cover != "https://covers.example/large.jpg" {
+		t.Errorf("cover = %q", book.Cover)
+	}
+	if book.Series != "Discworld" || book.SeriesPosition != 8 {
+		t.Errorf("series = %q %v, want Discworld 8", book.Series, book.SeriesPosition)
+	}
+	if book.WorkKey != "OL453936W" {
+		t.Errorf("work key = %q, want OL453936W", book.WorkKey)
+	}
+}
+
+func TestOpenLibraryWithoutEdition(t *testing.T) {
+	provider := newOpenLibraryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/books" {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"ISBN:9780306406157": {"title": "Guards! Guards!"}}`))
+	})
+
+	book, err := provider.GetByISBN(context.Background(), "9780306406157")
+	if err != nil {
+		t.Fatalf("GetByISBN: %v", err)
+	}
+
+	if book.Series != "" || book.WorkKey != "" {
+		t.Errorf("series %q, work %q, want both empty", book.Series, book.WorkKey)
+	}
+	if book.Cover != "https://covers.openlibrary.org/b/isbn/9780306406157-L.jpg" {
+		t.Errorf("cover = %q, want the cover API fallback", book.Cover)
+	}
+}
+
+func TestOpenLibraryErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		notFound bool
+	}{
+		{
+			name: "unknown isbn",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{}`))
+			},
+			notFound: true,
+		},
+		{
+			name: "server error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "boom", http.StatusInternalServerError)
+			},
+		},
+		{
+			name: "unexpected json",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"ISBN:9780306406157": {"title": 42, "authors": "nobody"}}`))
+			},
+		},
+		{
+			name: "not json",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`<html>`))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newOpenLibraryServer(t, tt.handler)
+
+			book, err := provider.GetByISBN(context.Background(), "9780306406157")
+			if err == nil {
+				t.Fatalf("got %+v, want an error", book)
+			}
+			if errors.Is(err, ErrNotFound) != tt.notFound {
+				t.Fatalf("err = %v, ErrNotFound = %t", err, tt.notFound)
+			}
+		})
+	}
+}
+
+func TestOpenLibraryContextCancel(t *testing.T) {
+	provider := newOpenLibraryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := provider.GetByISBN(ctx, "9780306406157"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestParseOpenLibrarySeries(t *testing.T) {
+	tests := []struct {
+		value    string
+		name     string
+		position float64
+	}{
+		{"Discworld ; 3", "Discworld", 3},
+		{"Discworld -- 3.5", "Discworld", 3.5},
+		{"A Song of Ice and Fire, book 1", "A Song of Ice and Fire", 1},
+		{"The Expanse (#4)", "The Expanse", 4},
+		{"Penguin Classics", "Penguin Classics", 0},
+	}
+
+	for _, tt := range tests {
+		name, position := parseOpenLibrarySeries(tt.value)
+		if name != tt.name || position != tt.position {
+			t.Errorf("parseOpenLibrarySeries(%q) = %q, %v, want %q, %v", tt.value, name, position, tt.name, tt.position)
+		}
+	}
+}
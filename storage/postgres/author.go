@@ -0,0 +1,192 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/models"
+	"book/pkg/helper"
+
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sort"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type AuthorRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewAuthorRepo(db *pgxpool.Pool) *AuthorRepo {
+	return &AuthorRepo{
+		db: db,
+	}
+}
+
+// bookAuthorsColumn selects the ordered author list of "book" as JSON.
+const bookAuthorsColumn = `
+			COALESCE((
+				SELECT json_agg(json_build_object(
+					'id', a."id",
+					'name', a."name",
+					'role', ba."role",
+					'position', ba."position"
+				) ORDER BY ba."position", a."name")
+				FROM "book_authors" ba
+				JOIN "authors" a ON a."id" = ba."author_id"
+				WHERE ba."book_id" = "book"."id"
+			), '[]')`
+
+type bookAuthorJSON struct {
+	Id       int32  `json:"id"`
+	Name     string `json:"name"`
+	Role     string `json:"role"`
+	Position int32  `json:"position"`
+}
+
+func decodeBookAuthors(data []byte) ([]*book_service.BookAuthor, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var rows []bookAuthorJSON
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, err
+	}
+
+	authors := make([]*book_service.BookAuthor, 0, len(rows))
+	for _, row := range rows {
+		authors = append(authors, &book_service.BookAuthor{
+			Id:       row.Id,
+			Name:     row.Name,
+			Role:     row.Role,
+			Position: row.Position,
+		})
+	}
+
+	return authors, nil
+}
+
+// setBookAuthors replaces the author list of a book, creating missing
+// authors by name. Authors are ordered by their position, ties keeping the
+// slice order, and stored with dense positions from 0 once empty names and
+// repeats are dropped.
+func setBookAuthors(ctx context.Context, tx pgx.Tx, bookID int32, authors []*book_service.BookAuthor) error {
+	_, err := tx.Exec(ctx, `DELETE FROM "book_authors" WHERE "book_id" = $1`, bookID)
+	if err != nil {
+		return err
+	}
+
+	ordered := make([]*book_service.BookAuthor, 0, len(authors))
+	for _, author := range authors {
+		if author.GetName() != "" {
+			ordered = append(ordered, author)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].GetPosition() < ordered[j].GetPosition()
+	})
+
+	var position int32
+	for _, author := range ordered {
+
+		role := author.GetRole()
+		if role == "" {
+			role = models.AuthorRoleAuthor
+		}
+
+		var authorID int32
+		err = tx.QueryRow(ctx, `
+			INSERT INTO "authors" ("name") VALUES ($1)
+			ON CONFLICT ("name") DO UPDATE SET "name" = EXCLUDED."name"
+			RETURNING "id"
+		`, author.GetName()).Scan(&authorID)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.Exec(ctx, `
+			INSERT INTO "book_authors" ("book_id", "author_id", "role", "position")
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT DO NOTHING
+		`, bookID, authorID, role, position)
+		if err != nil {
+			return err
+		}
+		position += int32(result.RowsAffected())
+	}
+
+	return nil
+}
+
+func (a *AuthorRepo) GetAll(ctx context.Context, userID int32, req *book_service.AuthorListRequest) (resp *book_service.AuthorListResponse, err error) {
+	resp = &book_service.AuthorListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = ` ORDER BY "book_count" DESC, a."name"`
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),
+			a."id",
+			a."name",
+			COUNT(DISTINCT b."id") AS "book_count"
+		FROM "authors" a
+		JOIN "book_authors" ba ON ba."author_id" = a."id"
+		JOIN "book" b ON b."id" = ba."book_id" AND b."user_id" = :user_id
+	`
+	params["user_id"] = userID
+	if len(req.GetSearch()) > 0 {
+		filter += ` AND a."name" ILIKE '%' || :search || '%' `
+		params["search"] = req.Search
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + ` GROUP BY a."id", a."name" ` + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := a.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id        sql.NullInt32
+			name      sql.NullString
+			bookCount sql.NullInt32
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&name,
+			&bookCount,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Authors = append(resp.Authors, &book_service.Author{
+			Id:        id.Int32,
+			Name:      name.String,
+			BookCount: bookCount.Int32,
+		})
+	}
+
+	return
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+
+	"context"
+	"testing"
+)
+
+// TestBookAuthors stores an author list out of order and with a repeat, and
+// lists the authors of the user's books.
+func TestBookAuthors(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books := NewBookRepo(pool)
+
+	pk, err := books.Create(ctx, userID, &book_service.Book{
+		Isbn:  "9780060853983",
+		Title: "Good Omens",
+		Pages: 432,
+		Authors: []*book_service.BookAuthor{
+			{Name: "Terry Pratchett", Position: 1},
+			{Name: "Neil Gaiman", Position: 0},
+			{Name: "Terry Pratchett", Position: 2},
+			{Name: ""},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	book, err := books.GetByPKey(ctx, userID, pk)
+	if err != nil {
+		t.Fatalf("GetByPKey: %v", err)
+	}
+
+	want := []string{"Neil Gaiman", "Terry Pratchett"}
+	if len(book.Authors) != len(want) {
+		t.Fatalf("authors = %v, want %v", book.Authors, want)
+	}
+	for i, author := range book.Authors {
+		if author.Name != want[i] || author.Position != int32(i) || author.Role != "author" {
+			t.Fatalf("author %d = %v, want %s at %d as author", i, author, want[i], i)
+		}
+	}
+
+	authors, err := NewAuthorRepo(pool).GetAll(ctx, userID, &book_service.AuthorListRequest{Search: "pratch"})
+	if err != nil {
+		t.Fatalf("GetAll authors: %v", err)
+	}
+	if authors.Count != 1 || authors.Authors[0].Name != "Terry Pratchett" || authors.Authors[0].BookCount != 1 {
+		t.Fatalf("authors matching pratch = %v, want Terry Pratchett with 1 book", authors.Authors)
+	}
+}
@@ -1,14 +1,19 @@
 package postgres
 
 import (
+	"book/config"
 	"book/genproto/book_service"
 	"book/models"
 	"book/pkg/helper"
+	"book/storage"
 	"fmt"
 
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -21,16 +26,9 @@ func NewBookRepo(db *pgxpool.Pool) *BookRepo {
 		db: db,
 	}
 }
-func (u *BookRepo) Create(ctx context.Context, req *book_service.CreateBook) (*book_service.BookPK, error) {
 
-	bookInfo, err := helper.GetBookByISBN(req.Isbn)
-	if err != nil {
-		fmt.Println("error from api")
-		return nil, err
-	}
-
-	query := `
-		INSERT INTO "book" (
+const bookColumns = `
+			"id",
 			"isbn",
 			"title",
 			"cover",
@@ -38,58 +36,65 @@ func (u *BookRepo) Create(ctx context.Context, req *book_service.CreateBook) (*b
 			"published",
 			"pages",
 			"status",
-			"created_at",
-			"updated_at"
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
-		RETURNING id
+			"field_sources",
+			"source",
+			"ownership",
+			"borrowed_from",
+			COALESCE(TO_CHAR("return_by", 'YYYY-MM-DD'), ''),
+			"priority",
+			"where_to_buy",
+			COALESCE("series_id", 0),` + bookSeriesNameColumn + `,
+			COALESCE("series_position", 0)::FLOAT8,` + bookWorkColumns + `,` + bookAuthorsColumn + `,` + bookProgressColumns + `,
+			` + bookRatingExpr + ` AS "rating",` + bookTagsColumn + `,` + bookLentColumn + `,` + bookCopyCountColumn + `,` + bookReadCountColumn + `
 `
 
-	var id int
-	err = u.db.QueryRow(
-		ctx,
-		query,
-		bookInfo.Isbn,
-		bookInfo.Title,
-		bookInfo.Cover,
-		bookInfo.Author,
-		bookInfo.Published,
-		bookInfo.Pages,
-		bookInfo.Status,
-	).Scan(&id)
-	if err != nil {
-		return nil, err
-	}
-
-	return &book_service.BookPK{Id: int32(id)}, nil
+// bookSortColumns whitelists BookListRequest.sort_by values.
+var bookSortColumns = map[string]string{
+	"created_at":      `"book"."created_at"`,
+	"rating":          `"rating"`,
+	"title":           `"book"."title"`,
+	"priority":        `"book"."priority"`,
+	"series_position": `"book"."series_position"`,
 }
 
-func (u *BookRepo) GetByPKey(ctx context.Context, req *book_service.BookPK) (Book *book_service.Book, err error) {
-	query := `
-		SELECT
-			"id",
-			"isbn",
-			"title",
-			"cover",
-			"author",
-			"published",
-			"pages",
-			"status"
-		FROM "book"
-		WHERE "id" = $1
-	`
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
 
+func scanBook(row rowScanner, dest ...interface{}) (*book_service.Book, error) {
 	var (
-		id        sql.NullInt32
-		isbn      sql.NullString
-		title     sql.NullString
-		cover     sql.NullString
-		author    sql.NullString
-		published sql.NullString
-		pages     sql.NullInt32
-		status    sql.NullInt32
+		id           sql.NullInt32
+		isbn         sql.NullString
+		title        sql.NullString
+		cover        sql.NullString
+		author       sql.NullString
+		published    sql.NullString
+		pages        sql.NullInt32
+		status       sql.NullInt32
+		fieldSources []byte
+		source       sql.NullString
+		ownership    sql.NullInt32
+		borrowedFrom sql.NullString
+		returnBy     sql.NullString
+		priority     sql.NullInt32
+		whereToBuy   sql.NullString
+		seriesID     sql.NullInt32
+		seriesName   sql.NullString
+		seriesPos    sql.NullFloat64
+		workID       sql.NullInt32
+		workKey      sql.NullString
+		editionCount sql.NullInt32
+		authors      []byte
+		currentPage  sql.NullInt32
+		percent      sql.NullFloat64
+		rating       sql.NullFloat64
+		tags         []byte
+		lent         sql.NullBool
+		copyCount    sql.NullInt32
+		readCount    sql.NullInt32
 	)
 
-	err = u.db.QueryRow(ctx, query, req.Id).Scan(
+	err := row.Scan(append(dest,
 		&id,
 		&isbn,
 		&title,
@@ -98,12 +103,33 @@ func (u *BookRepo) GetByPKey(ctx context.Context, req *book_service.BookPK) (Boo
 		&published,
 		&pages,
 		&status,
-	)
+		&fieldSources,
+		&source,
+		&ownership,
+		&borrowedFrom,
+		&returnBy,
+		&priority,
+		&whereToBuy,
+		&seriesID,
+		&seriesName,
+		&seriesPos,
+		&workID,
+		&workKey,
+		&editionCount,
+		&authors,
+		&currentPage,
+		&percent,
+		&rating,
+		&tags,
+		&lent,
+		&copyCount,
+		&readCount,
+	)...)
 	if err != nil {
-		return Book, err
+		return nil, err
 	}
 
-	Book = &book_service.Book{
+	book := &book_service.Book{
 		Id:        id.Int32,
 		Isbn:      isbn.String,
 		Title:     title.String,
@@ -111,73 +137,206 @@ func (u *BookRepo) GetByPKey(ctx context.Context, req *book_service.BookPK) (Boo
 		Author:    author.String,
 		Published: published.String,
 		Pages:     int32(pages.Int32),
-		Status:    int32(status.Int32),
+		Status:    book_service.BookStatus(status.Int32),
+		Source:    source.String,
+
+		Ownership:    book_service.Ownership(ownership.Int32),
+		BorrowedFrom: borrowedFrom.String,
+		ReturnBy:     returnBy.String,
+		Priority:     priority.Int32,
+		WhereToBuy:   whereToBuy.String,
+
+		SeriesId:       seriesID.Int32,
+		SeriesName:     seriesName.String,
+		SeriesPosition: float32(seriesPos.Float64),
+
+		WorkId:       workID.Int32,
+		WorkKey:      workKey.String,
+		EditionCount: editionCount.Int32,
+
+		CurrentPage:     currentPage.Int32,
+		PercentComplete: float32(percent.Float64),
+		Rating:          float32(rating.Float64),
+		Lent:            lent.Bool,
+		CopyCount:       copyCount.Int32,
+		ReadCount:       readCount.Int32,
 	}
 
-	return
+	if len(fieldSources) > 0 {
+		if err := json.Unmarshal(fieldSources, &book.FieldSources); err != nil {
+			return nil, err
+		}
+	}
+
+	book.Authors, err = decodeBookAuthors(authors)
+	if err != nil {
+		return nil, err
+	}
+
+	book.Tags, err = decodeBookTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return book, nil
 }
 
-func (u *BookRepo) GetBookByTitle(ctx context.Context, req *book_service.BookByTitle) (Book *book_service.Book, err error) {
+func (u *BookRepo) Create(ctx context.Context, userID int32, req *book_service.Book) (*book_service.BookPK, error) {
 	query := `
-			SELECT
-		    "id",
-		    "isbn",
-		    "title",
-		    "cover",
-		    "author",
-		    "published",
-		    "pages",
-		    "status"
-		FROM "book"
-		WHERE "title" ILIKE '%' || $1 || '%'
-		LIMIT 1;
-	`
+		INSERT INTO "book" (
+			"user_id",
+			"isbn",
+			"title",
+			"cover",
+			"author",
+			"published",
+			"pages",
+			"status",
+			"field_sources",
+			"source",
+			"ownership",
+			"borrowed_from",
+			"return_by",
+			"priority",
+			"where_to_buy",
+			"work_id",
+			"created_at",
+			"updated_at"
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9::jsonb, $10, $11, $12, $13::DATE, $14, $15, NULLIF($16, 0), NOW(), NOW())
+		RETURNING id
+`
+
+	fieldSources, err := json.Marshal(req.FieldSources)
+	if err != nil {
+		return nil, err
+	}
 
-	row := u.db.QueryRow(ctx, query, req.Title)
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
 
+	// a new edition of a work already on the shelf keeps the work's status,
+	// but finished reads stay with the editions that were read; an edition
+	// that inherits READING opens a read of its own for its progress
 	var (
-		id        sql.NullInt32
-		isbn      sql.NullString
-		title     sql.NullString
-		cover     sql.NullString
-		author    sql.NullString
-		published sql.NullString
-		pages     sql.NullInt32
-		status    sql.NullInt32
+		workID          int32
+		statusInherited bool
 	)
+	if req.WorkKey != "" {
+		var inherited *book_service.BookStatus
 
-	err = row.Scan(
-		&id,
-		&isbn,
-		&title,
-		&cover,
-		&author,
-		&published,
-		&pages,
-		&status,
-	)
+		workID, inherited, err = linkWork(ctx, tx, userID, req.FieldSources["work"], req.WorkKey, req.Title)
+		if err != nil {
+			return nil, err
+		}
+		if inherited != nil {
+			req.Status = *inherited
+			statusInherited = true
+		}
+	}
+
+	var id int32
+	err = tx.QueryRow(
+		ctx,
+		query,
+		userID,
+		req.Isbn,
+		req.Title,
+		req.Cover,
+		req.Author,
+		req.Published,
+		req.Pages,
+		int32(req.Status),
+		string(fieldSources),
+		req.Source,
+		int32(req.Ownership),
+		req.BorrowedFrom,
+		helper.NewNullString(req.ReturnBy),
+		req.Priority,
+		req.WhereToBuy,
+		workID,
+	).Scan(&id)
+	if isUniqueViolation(err) {
+		return nil, storage.ErrAlreadyExists
+	}
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil // Book not found
+		return nil, err
+	}
+
+	if err := setBookAuthors(ctx, tx, id, req.Authors); err != nil {
+		return nil, err
+	}
+
+	if req.SeriesName != "" {
+		if err := setBookSeriesByName(ctx, tx, userID, id, req.SeriesName, req.SeriesPosition); err != nil {
+			return nil, err
 		}
+	}
+
+	// only books we own have copies, and so only they can be lent
+	if req.Ownership == book_service.Ownership_OWNED {
+		if err := addFirstCopy(ctx, tx, userID, id); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO "book_status_history" ("book_id", "from_status", "to_status")
+		VALUES ($1, NULL, $2)
+	`, id, int32(req.Status))
+	if err != nil {
 		return nil, err
 	}
 
-	Book = &book_service.Book{
-		Id:        id.Int32,
-		Isbn:      isbn.String,
-		Title:     title.String,
-		Cover:     cover.String,
-		Author:    author.String,
-		Published: published.String,
-		Pages:     int32(pages.Int32),
-		Status:    int32(status.Int32),
+	if req.Status == book_service.BookStatus_READING || (req.Status == book_service.BookStatus_FINISHED && !statusInherited) {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO "reads" ("book_id", "finished_at")
+			VALUES ($1, CASE WHEN $2::INTEGER = 2 THEN NOW() END)
+		`, id, int32(req.Status))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &book_service.BookPK{Id: id}, nil
+}
+
+func (u *BookRepo) GetByPKey(ctx context.Context, userID int32, req *book_service.BookPK) (Book *book_service.Book, err error) {
+	query := `
+		SELECT` + bookColumns + `
+		FROM "book"
+		WHERE "id" = $1 AND "user_id" = $2
+	`
+
+	return scanBook(u.db.QueryRow(ctx, query, req.Id, userID))
+}
+
+func (u *BookRepo) GetBookByTitle(ctx context.Context, userID int32, req *book_service.BookByTitle) (Book *book_service.Book, err error) {
+	query := `
+		SELECT` + bookColumns + `
+		FROM "book"
+		WHERE "user_id" = $2 AND "title" ILIKE '%' || $1 || '%'
+		LIMIT 1;
+	`
+
+	Book, err = scanBook(u.db.QueryRow(ctx, query, req.Title, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Book not found
+		}
+		return nil, err
 	}
 
 	return
 }
 
-func (u *BookRepo) GetAll(ctx context.Context, req *book_service.BookListRequest) (resp *book_service.BookListResponse, err error) {
+func (u *BookRepo) GetAll(ctx context.Context, userID int32, req *book_service.BookListRequest) (resp *book_service.BookListResponse, err error) {
 	resp = &book_service.BookListResponse{}
 
 	var (
@@ -185,30 +344,80 @@ func (u *BookRepo) GetAll(ctx context.Context, req *book_service.BookListRequest
 		limit  = ""
 		offset = " OFFSET 0 "
 		params = make(map[string]interface{})
-		filter = " WHERE TRUE "
+		filter = " WHERE user_id = :user_id "
 		sort   = " ORDER BY created_at DESC"
 	)
 
 	query = `
 		SELECT
 			COUNT(*) OVER(),
-			"id",
-		    "isbn",
-		    "title",
-		    "cover",
-		    "author",
-		    "published",
-		    "pages",
-		    "status"
+			COUNT(*) FILTER (WHERE "ownership" = 0) OVER(),
+			COALESCE(AVG(` + bookRatingExpr + `) OVER(), 0),` + bookColumns + `
 		FROM "book"
 	`
+	params["user_id"] = userID
 	if len(req.GetSearch()) > 0 {
-		filter += " AND (title || ' ' || isbn) ILIKE '%' || '" + req.Search + "' || '%' "
+		filter += " AND (title || ' ' || isbn) ILIKE '%' || :search || '%' "
+		params["search"] = req.Search
 	}
 	if req.GetLimit() > 0 {
 		limit = " LIMIT :limit"
 		params["limit"] = req.Limit
 	}
+	if req.GetAuthorId() > 0 {
+		filter += ` AND EXISTS (SELECT 1 FROM "book_authors" ba WHERE ba."book_id" = "book"."id" AND ba."author_id" = :author_id) `
+		params["author_id"] = req.AuthorId
+	}
+	if len(req.GetAuthorName()) > 0 {
+		filter += ` AND EXISTS (
+			SELECT 1 FROM "book_authors" ba
+			JOIN "authors" a ON a."id" = ba."author_id"
+			WHERE ba."book_id" = "book"."id" AND a."name" ILIKE '%' || :author_name || '%'
+		) `
+		params["author_name"] = req.AuthorName
+	}
+	if req.GetMinRating() > 0 {
+		filter += " AND " + bookRatingExpr + " >= :min_rating "
+		params["min_rating"] = req.MinRating
+	}
+	if req.GetMaxRating() > 0 {
+		filter += " AND " + bookRatingExpr + " <= :max_rating "
+		params["max_rating"] = req.MaxRating
+	}
+	if len(req.GetAnyTagIds()) > 0 {
+		filter += ` AND EXISTS (SELECT 1 FROM "book_tags" bt WHERE bt."book_id" = "book"."id" AND bt."tag_id" = ANY(:any_tag_ids)) `
+		params["any_tag_ids"] = helper.Unique(req.AnyTagIds)
+	}
+	if len(req.GetAllTagIds()) > 0 {
+		allTagIds := helper.Unique(req.AllTagIds)
+		filter += ` AND (SELECT COUNT(*) FROM "book_tags" bt WHERE bt."book_id" = "book"."id" AND bt."tag_id" = ANY(:all_tag_ids)) = :all_tag_count `
+		params["all_tag_ids"] = allTagIds
+		params["all_tag_count"] = len(allTagIds)
+	}
+	if req.GetCollapseByWork() {
+		filter += " AND " + bookEarliestEditionCond
+	}
+	if req.GetSeriesId() > 0 {
+		filter += ` AND "series_id" = :series_id `
+		params["series_id"] = req.SeriesId
+	}
+	if req.GetLocationId() > 0 {
+		filter += ` AND EXISTS (SELECT 1 FROM "copies" c WHERE c."book_id" = "book"."id" AND c."location_id" = :location_id) `
+		params["location_id"] = req.LocationId
+	}
+	switch req.GetWishlist() {
+	case book_service.WishlistFilter_WISHLIST_EXCLUDE:
+		filter += ` AND "ownership" <> 2 `
+	case book_service.WishlistFilter_WISHLIST_ONLY:
+		filter += ` AND "ownership" = 2 `
+	}
+	if column, ok := bookSortColumns[req.GetSortBy()]; ok {
+		direction := " DESC NULLS LAST"
+		if req.GetAscending() {
+			direction = " ASC NULLS LAST"
+		}
+		sort = " ORDER BY " + column + direction + `, "book"."id"`
+	}
 	if req.GetOffset() > 0 {
 		offset = " OFFSET :offset"
 		params["offset"] = req.Offset
@@ -223,48 +432,21 @@ func (u *BookRepo) GetAll(ctx context.Context, req *book_service.BookListRequest
 	defer rows.Close()
 
 	for rows.Next() {
-		var (
-			id        sql.NullInt32
-			isbn      sql.NullString
-			title     sql.NullString
-			cover     sql.NullString
-			author    sql.NullString
-			published sql.NullString
-			pages     sql.NullInt32
-			status    sql.NullInt32
-		)
+		var averageRating float64
 
-		err := rows.Scan(
-			&resp.Count,
-			&id,
-			&isbn,
-			&title,
-			&cover,
-			&author,
-			&published,
-			&pages,
-			&status,
-		)
+		book, err := scanBook(rows, &resp.Count, &resp.OwnedCount, &averageRating)
 		if err != nil {
 			return resp, err
 		}
 
-		resp.Books = append(resp.Books, &book_service.Book{
-			Id:        id.Int32,
-			Isbn:      isbn.String,
-			Title:     title.String,
-			Cover:     cover.String,
-			Author:    author.String,
-			Published: published.String,
-			Pages:     int32(pages.Int32),
-			Status:    int32(status.Int32),
-		})
+		resp.AverageRating = float32(averageRating)
+		resp.Books = append(resp.Books, book)
 	}
 
 	return
 }
 
-func (u *BookRepo) Update(ctx context.Context, req *book_service.UpdateBook) (rowsAffected int64, err error) {
+func (u *BookRepo) Update(ctx context.Context, userID int32, req *book_service.UpdateBook) (rowsAffected int64, err error) {
 	query := `
 		UPDATE "book"
 		SET
@@ -273,64 +455,215 @@ func (u *BookRepo) Update(ctx context.Context, req *book_service.UpdateBook) (ro
 			"author" = $3,
 			"published" = $4,
 			"pages" = $5,
-			"status" = $6,
 			"updated_at" = NOW()
-		WHERE "id" = $7
+		WHERE "id" = $6 AND "user_id" = $7
 	`
 
-	result, err := u.db.Exec(ctx, query,
+	author := req.Author
+	if len(req.Authors) > 0 {
+		author = req.Authors[0].GetName()
+	}
+
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	found, err := changeStatus(ctx, tx, userID, req.Id, req.Status)
+	if err != nil || !found {
+		return 0, err
+	}
+
+	result, err := tx.Exec(ctx, query,
 		req.Title,
 		req.Cover,
-		req.Author,
+		author,
 		req.Published,
 		req.Pages,
-		req.Status,
 		req.Id,
+		userID,
 	)
 	if err != nil {
 		return 0, err
 	}
 
+	if result.RowsAffected() > 0 && len(req.Authors) > 0 {
+		if err := setBookAuthors(ctx, tx, req.Id, req.Authors); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
 	return result.RowsAffected(), nil
 }
 
-func (u *BookRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchRequest) (rowsAffected int64, err error) {
-	query := `
-		UPDATE
-			"book"
-		SET
-			status = :status
-		WHERE
-			id = :id
-	`
+func (u *BookRepo) UpdatePatch(ctx context.Context, userID int32, req *models.UpdatePatchRequest) (rowsAffected int64, err error) {
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	found, err := changeStatus(ctx, tx, userID, req.Id, req.Updpatch.Status)
+	if err != nil || !found {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return 1, nil
+}
+
+// changeStatus moves a book to the given status inside tx, records the change
+// in book_status_history and opens or closes the book's read to match.
+// Moving to the current status is a no-op.
+func changeStatus(ctx context.Context, tx pgx.Tx, userID, bookID int32, to book_service.BookStatus) (found bool, err error) {
+	return recordStatus(ctx, tx, userID, bookID, to, false)
+}
 
-	fmt.Println(req)
+// recordStatus is changeStatus, with dnf marking the history entry as a read
+// given up.
+func recordStatus(ctx context.Context, tx pgx.Tx, userID, bookID int32, to book_service.BookStatus, dnf bool) (found bool, err error) {
+	var from int32
+
+	err = tx.QueryRow(ctx, `
+		SELECT "status" FROM "book" WHERE "id" = $1 AND "user_id" = $2 FOR UPDATE
+	`, bookID, userID).Scan(&from)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	current := book_service.BookStatus(from)
+	if current == to {
+		return true, nil
+	}
+
+	if !models.CanTransitionStatus(current, to) {
+		return true, fmt.Errorf("%w: %s -> %s", storage.ErrInvalidTransition, current, to)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE "book" SET "status" = $1, "updated_at" = NOW() WHERE "id" = $2
+	`, int32(to), bookID)
+	if err != nil {
+		return true, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO "book_status_history" ("book_id", "from_status", "to_status", "dnf")
+		VALUES ($1, $2, $3, $4)
+	`, bookID, from, int32(to), dnf)
+	if err != nil {
+		return true, err
+	}
 
-	args := map[string]interface{}{
-		"id":     req.Id,
-		"status": &req.Updpatch.Status,
+	if err := syncRead(ctx, tx, bookID, to); err != nil {
+		return true, err
 	}
 
-	query, namedArgs := helper.ReplaceQueryParams(query, args)
+	return true, nil
+}
 
-	fmt.Print(query)
-	fmt.Print(namedArgs...)
+func (u *BookRepo) GetStatusHistory(ctx context.Context, userID int32, req *book_service.BookPK) (resp *book_service.StatusHistoryResponse, err error) {
+	resp = &book_service.StatusHistoryResponse{}
 
-	result, err := u.db.Exec(ctx, query, namedArgs...)
+	query := `
+		SELECT
+			h."id",
+			h."book_id",
+			COALESCE(h."from_status", 0),
+			h."to_status",
+			h."from_status" IS NULL,
+			h."dnf",
+			TO_CHAR(h."changed_at", ` + config.DatabaseQueryTimeLayout + `)
+		FROM "book_status_history" h
+		JOIN "book" b ON b."id" = h."book_id"
+		WHERE h."book_id" = $1 AND b."user_id" = $2
+		ORDER BY h."changed_at", h."id"
+	`
+
+	rows, err := u.db.Query(ctx, query, req.Id, userID)
 	if err != nil {
-		return
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id         sql.NullInt32
+			bookID     sql.NullInt32
+			fromStatus sql.NullInt32
+			toStatus   sql.NullInt32
+			initial    sql.NullBool
+			dnf        sql.NullBool
+			changedAt  sql.NullString
+		)
+
+		err := rows.Scan(
+			&id,
+			&bookID,
+			&fromStatus,
+			&toStatus,
+			&initial,
+			&dnf,
+			&changedAt,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Changes = append(resp.Changes, &book_service.StatusChange{
+			Id:         id.Int32,
+			BookId:     bookID.Int32,
+			FromStatus: book_service.BookStatus(fromStatus.Int32),
+			ToStatus:   book_service.BookStatus(toStatus.Int32),
+			Initial:    initial.Bool,
+			Dnf:        dnf.Bool,
+			ChangedAt:  changedAt.String,
+		})
 	}
 
-	return result.RowsAffected(), err
+	return resp, rows.Err()
 }
 
-func (u *BookRepo) Delete(ctx context.Context, req *book_service.BookPK) error {
-	query := `DELETE FROM "book" WHERE "id" = $1`
+// Delete refuses to remove a book that is lent out unless req.Force is set.
+func (u *BookRepo) Delete(ctx context.Context, userID int32, req *book_service.DeleteBookRequest) error {
+	query := `
+		DELETE FROM "book"
+		WHERE "id" = $1 AND "user_id" = $2 AND ($3 OR NOT` + bookLentColumn + `)
+	`
 
-	_, err := u.db.Exec(ctx, query, req.Id)
+	result, err := u.db.Exec(ctx, query, req.Id, userID, req.Force)
 	if err != nil {
 		return err
 	}
 
+	if result.RowsAffected() == 0 && !req.Force {
+		query = `
+			SELECT` + bookLentColumn + `
+			FROM "book"
+			WHERE "id" = $1 AND "user_id" = $2
+		`
+
+		var lent bool
+		err = u.db.QueryRow(ctx, query, req.Id, userID).Scan(&lent)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		if lent {
+			return storage.ErrLent
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/models"
+	"book/storage"
+
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestStatusHistory refuses to finish an unread book and records every change
+// of a book read to the end.
+func TestStatusHistory(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books := NewBookRepo(pool)
+
+	pk, err := books.Create(ctx, userID, &book_service.Book{
+		Isbn:  "9780552131063",
+		Title: "Small Gods",
+		Pages: 384,
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	setStatus := func(status book_service.BookStatus) error {
+		_, err := books.UpdatePatch(ctx, userID, &models.UpdatePatchRequest{
+			Id:       pk.Id,
+			Updpatch: book_service.BookData{Status: status},
+		})
+		return err
+	}
+
+	if err := setStatus(book_service.BookStatus_FINISHED); !errors.Is(err, storage.ErrInvalidTransition) {
+		t.Fatalf("NEW -> FINISHED = %v, want %v", err, storage.ErrInvalidTransition)
+	}
+	for _, status := range []book_service.BookStatus{book_service.BookStatus_READING, book_service.BookStatus_FINISHED} {
+		if err := setStatus(status); err != nil {
+			t.Fatalf("move to %s: %v", status, err)
+		}
+	}
+
+	history, err := books.GetStatusHistory(ctx, userID, pk)
+	if err != nil {
+		t.Fatalf("GetStatusHistory: %v", err)
+	}
+
+	want := []struct {
+		from, to book_service.BookStatus
+		initial  bool
+	}{
+		{book_service.BookStatus_NEW, book_service.BookStatus_NEW, true},
+		{book_service.BookStatus_NEW, book_service.BookStatus_READING, false},
+		{book_service.BookStatus_READING, book_service.BookStatus_FINISHED, false},
+	}
+	if len(history.Changes) != len(want) {
+		t.Fatalf("history = %v, want %d changes", history.Changes, len(want))
+	}
+	for i, change := range history.Changes {
+		if change.FromStatus != want[i].from || change.ToStatus != want[i].to || change.Initial != want[i].initial {
+			t.Fatalf("change %d = %v, want %v", i, change, want[i])
+		}
+	}
+}
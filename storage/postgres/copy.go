@@ -0,0 +1,304 @@
+package postgres
+
+import (
+	"book/config"
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/storage"
+
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type CopyRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewCopyRepo(db *pgxpool.Pool) *CopyRepo {
+	return &CopyRepo{
+		db: db,
+	}
+}
+
+// bookCopyCountColumn counts the physical copies of "book".
+const bookCopyCountColumn = `
+			(SELECT COUNT(*) FROM "copies" c WHERE c."book_id" = "book"."id")`
+
+// copyLentExpr reports whether copy c is out on a loan that has not been
+// returned.
+const copyLentExpr = `
+			EXISTS (
+				SELECT 1 FROM "loans" l
+				WHERE l."copy_id" = c."id" AND l."returned_at" IS NULL
+			)`
+
+const copyColumns = `
+			c."id",
+			c."book_id",
+			(SELECT b."title" FROM "book" b WHERE b."id" = c."book_id"),
+			COALESCE(c."location_id", 0),
+			COALESCE((SELECT lo."name" FROM "locations" lo WHERE lo."id" = c."location_id"), ''),
+			c."condition",
+			COALESCE(TO_CHAR(c."acquired_at", 'YYYY-MM-DD'), ''),
+			c."notes",` + copyLentExpr + `,
+			TO_CHAR(c."created_at", ` + config.DatabaseQueryTimeLayout + `)
+`
+
+func scanCopy(row rowScanner, dest ...interface{}) (*book_service.Copy, error) {
+	var (
+		id           sql.NullInt32
+		bookID       sql.NullInt32
+		bookTitle    sql.NullString
+		locationID   sql.NullInt32
+		locationName sql.NullString
+		condition    sql.NullString
+		acquiredAt   sql.NullString
+		notes        sql.NullString
+		lent         sql.NullBool
+		createdAt    sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&bookID,
+		&bookTitle,
+		&locationID,
+		&locationName,
+		&condition,
+		&acquiredAt,
+		&notes,
+		&lent,
+		&createdAt,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &book_service.Copy{
+		Id:           id.Int32,
+		BookId:       bookID.Int32,
+		BookTitle:    bookTitle.String,
+		LocationId:   locationID.Int32,
+		LocationName: locationName.String,
+		Condition:    condition.String,
+		AcquiredAt:   acquiredAt.String,
+		Notes:        notes.String,
+		Lent:         lent.Bool,
+		CreatedAt:    createdAt.String,
+	}, nil
+}
+
+// addFirstCopy gives the book a copy when it has none yet.
+func addFirstCopy(ctx context.Context, tx pgx.Tx, userID, bookID int32) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO "copies" ("user_id", "book_id", "acquired_at")
+		SELECT $1::INTEGER, $2, CURRENT_DATE
+		WHERE NOT EXISTS (SELECT 1 FROM "copies" WHERE "book_id" = $2)
+	`, userID, bookID)
+
+	return err
+}
+
+func (c *CopyRepo) Create(ctx context.Context, userID int32, req *book_service.CreateCopyRequest) (*book_service.Copy, error) {
+	query := `
+		WITH c AS (
+			INSERT INTO "copies" (
+				"user_id",
+				"book_id",
+				"location_id",
+				"condition",
+				"acquired_at",
+				"notes"
+			)
+			SELECT $1, "id", NULLIF($3, 0), $4, $5::DATE, $6
+			FROM "book"
+			WHERE "id" = $2 AND "user_id" = $1 AND "ownership" = 0
+				AND ($3 = 0 OR EXISTS (SELECT 1 FROM "locations" WHERE "id" = $3 AND "user_id" = $1))
+			RETURNING *
+		)
+		SELECT` + copyColumns + `
+		FROM c
+	`
+
+	bookCopy, err := scanCopy(c.db.QueryRow(ctx, query,
+		userID,
+		req.BookId,
+		req.LocationId,
+		req.Condition,
+		helper.NewNullString(req.AcquiredAt),
+		req.Notes,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		var owned bool
+		err := c.db.QueryRow(ctx, `
+			SELECT "ownership" = 0 FROM "book" WHERE "id" = $1 AND "user_id" = $2
+		`, req.BookId, userID).Scan(&owned)
+		if err == nil && !owned {
+			return nil, storage.ErrNotOwned
+		}
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+
+		return nil, storage.ErrNotFound
+	}
+
+	return bookCopy, err
+}
+
+func (c *CopyRepo) GetByPKey(ctx context.Context, userID int32, req *book_service.CopyPK) (*book_service.Copy, error) {
+	query := `
+		SELECT` + copyColumns + `
+		FROM "copies" c
+		WHERE c."id" = $1 AND c."user_id" = $2
+	`
+
+	bookCopy, err := scanCopy(c.db.QueryRow(ctx, query, req.Id, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+
+	return bookCopy, err
+}
+
+func (c *CopyRepo) Update(ctx context.Context, userID int32, req *book_service.UpdateCopyRequest) (*book_service.Copy, error) {
+	query := `
+		WITH c AS (
+			UPDATE "copies"
+			SET
+				"condition" = $3,
+				"acquired_at" = $4::DATE,
+				"notes" = $5,
+				"updated_at" = NOW()
+			WHERE "id" = $1 AND "user_id" = $2
+			RETURNING *
+		)
+		SELECT` + copyColumns + `
+		FROM c
+	`
+
+	bookCopy, err := scanCopy(c.db.QueryRow(ctx, query,
+		req.Id,
+		userID,
+		req.Condition,
+		helper.NewNullString(req.AcquiredAt),
+		req.Notes,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+
+	return bookCopy, err
+}
+
+// Move puts the copy at req.LocationId, or takes it off any location when
+// req.LocationId is 0.
+func (c *CopyRepo) Move(ctx context.Context, userID int32, req *book_service.MoveCopyRequest) (*book_service.Copy, error) {
+	query := `
+		WITH c AS (
+			UPDATE "copies"
+			SET
+				"location_id" = NULLIF($3, 0),
+				"updated_at" = NOW()
+			WHERE "id" = $1 AND "user_id" = $2
+				AND ($3 = 0 OR EXISTS (SELECT 1 FROM "locations" WHERE "id" = $3 AND "user_id" = $2))
+			RETURNING *
+		)
+		SELECT` + copyColumns + `
+		FROM c
+	`
+
+	bookCopy, err := scanCopy(c.db.QueryRow(ctx, query, req.Id, userID, req.LocationId))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+
+	return bookCopy, err
+}
+
+// Delete removes the copy unless it is lent out, in which case it returns
+// storage.ErrLent.
+func (c *CopyRepo) Delete(ctx context.Context, userID int32, req *book_service.CopyPK) error {
+	query := `
+		DELETE FROM "copies" c
+		WHERE c."id" = $1 AND c."user_id" = $2 AND NOT` + copyLentExpr + `
+	`
+
+	result, err := c.db.Exec(ctx, query, req.Id, userID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() > 0 {
+		return nil
+	}
+
+	bookCopy, err := c.GetByPKey(ctx, userID, req)
+	if err != nil {
+		return err
+	}
+	if bookCopy.Lent {
+		return storage.ErrLent
+	}
+
+	return storage.ErrNotFound
+}
+
+func (c *CopyRepo) GetAll(ctx context.Context, userID int32, req *book_service.CopyListRequest) (resp *book_service.CopyListResponse, err error) {
+	resp = &book_service.CopyListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = ` WHERE c."user_id" = :user_id `
+		sort   = ` ORDER BY c."book_id", c."id"`
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),` + copyColumns + `
+		FROM "copies" c
+	`
+	params["user_id"] = userID
+	if req.GetBookId() > 0 {
+		filter += ` AND c."book_id" = :book_id `
+		params["book_id"] = req.BookId
+	}
+	if req.GetLocationId() > 0 {
+		filter += ` AND c."location_id" = :location_id `
+		params["location_id"] = req.LocationId
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		bookCopy, err := scanCopy(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Copies = append(resp.Copies, bookCopy)
+	}
+
+	return resp, rows.Err()
+}
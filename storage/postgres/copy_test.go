@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/storage"
+
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestLendCopies lends both copies of a book, one of them kept in the study,
+// and checks each copy goes out and comes back on its own.
+func TestLendCopies(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books, copies, loans := NewBookRepo(pool), NewCopyRepo(pool), NewLoanRepo(pool)
+
+	book, err := books.Create(ctx, userID, &book_service.Book{
+		Isbn:  "9780552131063",
+		Title: "Small Gods",
+		Pages: 384,
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	study, err := NewLocationRepo(pool).Create(ctx, userID, &book_service.CreateLocationRequest{Name: "Study"})
+	if err != nil {
+		t.Fatalf("Create location: %v", err)
+	}
+
+	second, err := copies.Create(ctx, userID, &book_service.CreateCopyRequest{
+		BookId:     book.Id,
+		LocationId: study.Id,
+		Condition:  "fine",
+	})
+	if err != nil {
+		t.Fatalf("Create copy: %v", err)
+	}
+
+	inStudy, err := books.GetAll(ctx, userID, &book_service.BookListRequest{LocationId: study.Id})
+	if err != nil {
+		t.Fatalf("GetAll in the study: %v", err)
+	}
+	if len(inStudy.Books) != 1 {
+		t.Fatalf("%d books in the study, want 1", len(inStudy.Books))
+	}
+
+	lend := &book_service.LendBookRequest{BookId: book.Id, Borrower: "Rincewind"}
+	first, err := loans.Lend(ctx, userID, lend)
+	if err != nil {
+		t.Fatalf("Lend: %v", err)
+	}
+	if _, err := loans.Lend(ctx, userID, lend); err != nil {
+		t.Fatalf("Lend the second copy: %v", err)
+	}
+	if _, err := loans.Lend(ctx, userID, lend); !errors.Is(err, storage.ErrAlreadyExists) {
+		t.Fatalf("Lend with every copy out = %v, want %v", err, storage.ErrAlreadyExists)
+	}
+
+	if err := copies.Delete(ctx, userID, &book_service.CopyPK{Id: second.Id}); !errors.Is(err, storage.ErrLent) {
+		t.Fatalf("Delete lent copy = %v, want %v", err, storage.ErrLent)
+	}
+
+	if _, err := loans.Return(ctx, userID, &book_service.ReturnBookRequest{CopyId: second.Id}); err != nil {
+		t.Fatalf("Return: %v", err)
+	}
+
+	returned, err := copies.GetByPKey(ctx, userID, &book_service.CopyPK{Id: second.Id})
+	if err != nil {
+		t.Fatalf("GetByPKey: %v", err)
+	}
+	if returned.Lent || returned.LocationName != "Study" {
+		t.Fatalf("returned copy = %v, want it back in the study", returned)
+	}
+
+	lent, err := copies.GetByPKey(ctx, userID, &book_service.CopyPK{Id: first.CopyId})
+	if err != nil {
+		t.Fatalf("GetByPKey: %v", err)
+	}
+	if !lent.Lent {
+		t.Fatalf("copy %d is not lent, want it still out", lent.Id)
+	}
+
+	if err := copies.Delete(ctx, userID, &book_service.CopyPK{Id: second.Id}); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+}
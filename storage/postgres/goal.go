@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"book/config"
+	"book/genproto/book_service"
+	"book/storage"
+
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type GoalRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewGoalRepo(db *pgxpool.Pool) *GoalRepo {
+	return &GoalRepo{
+		db: db,
+	}
+}
+
+const goalColumns = `
+			g."id",
+			g."year",
+			g."metric",
+			g."target",
+			TO_CHAR(g."created_at", ` + config.DatabaseQueryTimeLayout + `)
+`
+
+func scanGoal(row rowScanner, dest ...interface{}) (*book_service.Goal, error) {
+	var (
+		id        sql.NullInt32
+		year      sql.NullInt32
+		metric    sql.NullInt32
+		target    sql.NullInt32
+		createdAt sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&year,
+		&metric,
+		&target,
+		&createdAt,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &book_service.Goal{
+		Id:        id.Int32,
+		Year:      year.Int32,
+		Metric:    book_service.GoalMetric(metric.Int32),
+		Target:    target.Int32,
+		CreatedAt: createdAt.String,
+	}, nil
+}
+
+func (g *GoalRepo) Set(ctx context.Context, userID int32, req *book_service.SetGoalRequest) (*book_service.Goal, error) {
+	query := `
+		WITH g AS (
+			INSERT INTO "goals" ("user_id", "year", "metric", "target") VALUES ($1, $2, $3, $4)
+			ON CONFLICT ("user_id", "year", "metric") DO UPDATE
+			SET "target" = EXCLUDED."target", "updated_at" = NOW()
+			RETURNING *
+		)
+		SELECT` + goalColumns + `
+		FROM g
+	`
+
+	return scanGoal(g.db.QueryRow(ctx, query, userID, req.Year, int32(req.Metric), req.Target))
+}
+
+func (g *GoalRepo) GetByPKey(ctx context.Context, userID int32, req *book_service.GoalPK) (*book_service.Goal, error) {
+	query := `
+		SELECT` + goalColumns + `
+		FROM "goals" g
+		WHERE g."id" = $1 AND g."user_id" = $2
+	`
+
+	goal, err := scanGoal(g.db.QueryRow(ctx, query, req.Id, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+
+	return goal, err
+}
+
+func (g *GoalRepo) GetAll(ctx context.Context, userID int32, req *book_service.GoalListRequest) (resp *book_service.GoalListResponse, err error) {
+	resp = &book_service.GoalListResponse{}
+
+	query := `
+		SELECT
+			COUNT(*) OVER(),` + goalColumns + `
+		FROM "goals" g
+		WHERE g."user_id" = $1 AND ($2 = 0 OR g."year" = $2)
+		ORDER BY g."year" DESC, g."metric"
+	`
+
+	rows, err := g.db.Query(ctx, query, userID, req.GetYear())
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		goal, err := scanGoal(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Goals = append(resp.Goals, goal)
+	}
+
+	return resp, rows.Err()
+}
+
+func (g *GoalRepo) Delete(ctx context.Context, userID int32, req *book_service.GoalPK) (int64, error) {
+	query := `DELETE FROM "goals" WHERE "id" = $1 AND "user_id" = $2`
+
+	result, err := g.db.Exec(ctx, query, req.Id, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// GetDone counts the books or pages the user finished in the goal's year.
+// Every read-through counts, so a reread book counts again.
+func (g *GoalRepo) GetDone(ctx context.Context, userID int32, goal *book_service.Goal) (int32, error) {
+	query := `
+		SELECT COUNT(*), COALESCE(SUM("book"."pages"), 0)
+		FROM "reads" r
+		JOIN "book" ON "book"."id" = r."book_id"
+		WHERE "book"."user_id" = $1 AND EXTRACT(YEAR FROM r."finished_at") = $2
+			AND` + readCountedCond + `
+	`
+
+	var books, pages int32
+	err := g.db.QueryRow(ctx, query, userID, goal.Year).Scan(&books, &pages)
+	if err != nil {
+		return 0, err
+	}
+
+	if goal.Metric == book_service.GoalMetric_PAGES {
+		return pages, nil
+	}
+
+	return books, nil
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+
+	"context"
+	"testing"
+	"time"
+)
+
+// TestGoalDone reads a book twice and gives up on a third read; the first two
+// count towards this year's goals.
+func TestGoalDone(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books, reads, goals := NewBookRepo(pool), NewReadRepo(pool), NewGoalRepo(pool)
+	year := int32(time.Now().UTC().Year())
+
+	goal, err := goals.Set(ctx, userID, &book_service.SetGoalRequest{Year: year, Metric: book_service.GoalMetric_BOOKS, Target: 10})
+	if err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	again, err := goals.Set(ctx, userID, &book_service.SetGoalRequest{Year: year, Metric: book_service.GoalMetric_BOOKS, Target: 12})
+	if err != nil {
+		t.Fatalf("Set again: %v", err)
+	}
+	if again.Id != goal.Id || again.Target != 12 {
+		t.Fatalf("goal set again = %v, want goal %d with target 12", again, goal.Id)
+	}
+
+	pk, err := books.Create(ctx, userID, &book_service.Book{
+		Isbn:   "9780552131063",
+		Title:  "Small Gods",
+		Pages:  384,
+		Status: book_service.BookStatus_FINISHED,
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if _, err := reads.Start(ctx, userID, pk); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	_, err = books.UpdateProgress(ctx, userID, &book_service.UpdateProgressRequest{
+		BookId:   pk.Id,
+		Progress: &book_service.UpdateProgressRequest_Page{Page: 384},
+	})
+	if err != nil {
+		t.Fatalf("UpdateProgress: %v", err)
+	}
+
+	if _, err := reads.Start(ctx, userID, pk); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if _, err := reads.Abandon(ctx, userID, pk); err != nil {
+		t.Fatalf("Abandon: %v", err)
+	}
+
+	for metric, want := range map[book_service.GoalMetric]int32{
+		book_service.GoalMetric_BOOKS: 2,
+		book_service.GoalMetric_PAGES: 768,
+	} {
+		done, err := goals.GetDone(ctx, userID, &book_service.Goal{Year: year, Metric: metric})
+		if err != nil {
+			t.Fatalf("GetDone %s: %v", metric, err)
+		}
+		if done != want {
+			t.Fatalf("%s done = %d, want %d", metric, done, want)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package postgres
+
+import (
+	"book/config"
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/storage"
+
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type LoanRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewLoanRepo(db *pgxpool.Pool) *LoanRepo {
+	return &LoanRepo{
+		db: db,
+	}
+}
+
+// bookLentColumn reports whether a copy of "book" is out on a loan that has
+// not been returned.
+const bookLentColumn = `
+			EXISTS (
+				SELECT 1 FROM "loans" l
+				WHERE l."book_id" = "book"."id" AND l."returned_at" IS NULL
+			)`
+
+const loanColumns = `
+			l."id",
+			l."book_id",
+			(SELECT b."title" FROM "book" b WHERE b."id" = l."book_id"),
+			l."borrower",
+			l."contact",
+			TO_CHAR(l."lent_at", 'YYYY-MM-DD'),
+			COALESCE(TO_CHAR(l."due_at", 'YYYY-MM-DD'), ''),
+			COALESCE(TO_CHAR(l."returned_at", ` + config.DatabaseQueryTimeLayout + `), ''),
+			l."returned_at" IS NULL AND l."due_at" < CURRENT_DATE,
+			COALESCE(l."copy_id", 0),
+			COALESCE((
+				SELECT lo."name" FROM "copies" c
+				JOIN "locations" lo ON lo."id" = c."location_id"
+				WHERE c."id" = l."copy_id"
+			), '')
+`
+
+func scanLoan(row rowScanner, dest ...interface{}) (*book_service.Loan, error) {
+	var (
+		id         sql.NullInt32
+		bookID     sql.NullInt32
+		bookTitle  sql.NullString
+		borrower   sql.NullString
+		contact    sql.NullString
+		lentAt     sql.NullString
+		dueAt      sql.NullString
+		returnedAt sql.NullString
+		overdue    sql.NullBool
+		copyID     sql.NullInt32
+		location   sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&bookID,
+		&bookTitle,
+		&borrower,
+		&contact,
+		&lentAt,
+		&dueAt,
+		&returnedAt,
+		&overdue,
+		&copyID,
+		&location,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &book_service.Loan{
+		Id:         id.Int32,
+		BookId:     bookID.Int32,
+		BookTitle:  bookTitle.String,
+		Borrower:   borrower.String,
+		Contact:    contact.String,
+		LentAt:     lentAt.String,
+		DueAt:      dueAt.String,
+		ReturnedAt: returnedAt.String,
+		Overdue:    overdue.Bool,
+
+		CopyId:       copyID.Int32,
+		LocationName: location.String,
+	}, nil
+}
+
+// Lend lends req.CopyId, or the first copy of req.BookId that is not out.
+// ErrAlreadyExists means the copy, or every copy of the book, is lent out,
+// and ErrNotOwned that the book is not ours to lend.
+func (l *LoanRepo) Lend(ctx context.Context, userID int32, req *book_service.LendBookRequest) (*book_service.Loan, error) {
+	query := `
+		WITH c AS (
+			SELECT c."id", c."book_id" FROM "copies" c
+			JOIN "book" b ON b."id" = c."book_id" AND b."ownership" = 0
+			WHERE c."user_id" = $1 AND (c."id" = $7 OR ($7 = 0 AND c."book_id" = $2 AND NOT EXISTS (
+				SELECT 1 FROM "loans" o WHERE o."copy_id" = c."id" AND o."returned_at" IS NULL
+			)))
+			ORDER BY c."id"
+			LIMIT 1
+		), l AS (
+			INSERT INTO "loans" (
+				"user_id",
+				"book_id",
+				"copy_id",
+				"borrower",
+				"contact",
+				"lent_at",
+				"due_at"
+			)
+			SELECT $1, c."book_id", c."id", $3, $4, COALESCE($5::DATE, CURRENT_DATE), $6::DATE
+			FROM c
+			RETURNING *
+		)
+		SELECT` + loanColumns + `
+		FROM l
+	`
+
+	loan, err := scanLoan(l.db.QueryRow(ctx, query,
+		userID,
+		req.BookId,
+		req.Borrower,
+		req.Contact,
+		helper.NewNullString(req.LentAt),
+		helper.NewNullString(req.DueAt),
+		req.CopyId,
+	))
+	if errors.Is(err, pgx.ErrNoRows) && req.CopyId == 0 {
+		var (
+			owned  bool
+			copies int32
+		)
+		err := l.db.QueryRow(ctx, `
+			SELECT b."ownership" = 0, (SELECT COUNT(*) FROM "copies" c WHERE c."book_id" = b."id")
+			FROM "book" b
+			WHERE b."id" = $1 AND b."user_id" = $2
+		`, req.BookId, userID).Scan(&owned, &copies)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !owned {
+			return nil, storage.ErrNotOwned
+		}
+		if copies > 0 {
+			return nil, storage.ErrAlreadyExists
+		}
+		return nil, storage.ErrNotFound
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, storage.ErrAlreadyExists
+	}
+	if isCheckViolation(err) {
+		return nil, storage.ErrOutOfRange
+	}
+
+	return loan, err
+}
+
+// Return closes the open loan of req.CopyId, or the oldest open loan of
+// req.BookId. ErrOutOfRange means req.ReturnedAt is before the loan began.
+func (l *LoanRepo) Return(ctx context.Context, userID int32, req *book_service.ReturnBookRequest) (*book_service.Loan, error) {
+	query := `
+		WITH l AS (
+			UPDATE "loans"
+			SET "returned_at" = COALESCE($3::TIMESTAMP, NOW())
+			WHERE "id" = (
+				SELECT "id" FROM "loans"
+				WHERE "user_id" = $2 AND "returned_at" IS NULL
+					AND ("copy_id" = $4 OR ($4 = 0 AND "book_id" = $1))
+				ORDER BY "lent_at", "id"
+				LIMIT 1
+			)
+			RETURNING *
+		)
+		SELECT` + loanColumns + `
+		FROM l
+	`
+
+	returnedAt, err := helper.NewNullTime(req.ReturnedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	loan, err := scanLoan(l.db.QueryRow(ctx, query,
+		req.BookId,
+		userID,
+		returnedAt,
+		req.CopyId,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+	if isCheckViolation(err) {
+		return nil, storage.ErrOutOfRange
+	}
+
+	return loan, err
+}
+
+func (l *LoanRepo) GetAll(ctx context.Context, userID int32, req *book_service.LoanListRequest) (resp *book_service.LoanListResponse, err error) {
+	resp = &book_service.LoanListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = ` WHERE l."user_id" = :user_id `
+		sort   = ` ORDER BY l."lent_at" DESC, l."id" DESC`
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),` + loanColumns + `
+		FROM "loans" l
+	`
+	params["user_id"] = userID
+	if req.GetBookId() > 0 {
+		filter += ` AND l."book_id" = :book_id `
+		params["book_id"] = req.BookId
+	}
+	if req.GetOverdue() {
+		filter += ` AND l."returned_at" IS NULL AND l."due_at" < CURRENT_DATE `
+		sort = ` ORDER BY l."due_at", l."id"`
+	} else if !req.GetIncludeReturned() {
+		filter += ` AND l."returned_at" IS NULL `
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := l.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		loan, err := scanLoan(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Loans = append(resp.Loans, loan)
+	}
+
+	return resp, rows.Err()
+}
@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/storage"
+
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// TestOnlyOwnedBooksAreLent borrows a book, which must not give it a copy to
+// lend, then buys it, lends it and tries to give it back to the library.
+func TestOnlyOwnedBooksAreLent(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books, copies, loans := NewBookRepo(pool), NewCopyRepo(pool), NewLoanRepo(pool)
+
+	book, err := books.Create(ctx, userID, &book_service.Book{
+		Isbn:         "9780306406157",
+		Title:        "Mort",
+		Pages:        272,
+		Ownership:    book_service.Ownership_BORROWED,
+		BorrowedFrom: "library",
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	countCopies := func() int64 {
+		t.Helper()
+		resp, err := copies.GetAll(ctx, userID, &book_service.CopyListRequest{BookId: book.Id})
+		if err != nil {
+			t.Fatalf("GetAll copies: %v", err)
+		}
+		return resp.Count
+	}
+
+	if n := countCopies(); n != 0 {
+		t.Fatalf("borrowed book has %d copies, want 0", n)
+	}
+
+	_, err = loans.Lend(ctx, userID, &book_service.LendBookRequest{BookId: book.Id, Borrower: "Rincewind"})
+	if !errors.Is(err, storage.ErrNotOwned) {
+		t.Fatalf("Lend borrowed book = %v, want %v", err, storage.ErrNotOwned)
+	}
+
+	_, err = copies.Create(ctx, userID, &book_service.CreateCopyRequest{BookId: book.Id, Condition: "good"})
+	if !errors.Is(err, storage.ErrNotOwned) {
+		t.Fatalf("Create copy of borrowed book = %v, want %v", err, storage.ErrNotOwned)
+	}
+
+	_, err = books.SetOwnership(ctx, userID, &book_service.SetOwnershipRequest{
+		BookId:    book.Id,
+		Ownership: book_service.Ownership_OWNED,
+	})
+	if err != nil {
+		t.Fatalf("SetOwnership OWNED: %v", err)
+	}
+	if n := countCopies(); n != 1 {
+		t.Fatalf("owned book has %d copies, want 1", n)
+	}
+
+	_, err = loans.Lend(ctx, userID, &book_service.LendBookRequest{BookId: book.Id, Borrower: "Rincewind"})
+	if err != nil {
+		t.Fatalf("Lend: %v", err)
+	}
+
+	borrowed := &book_service.SetOwnershipRequest{
+		BookId:       book.Id,
+		Ownership:    book_service.Ownership_BORROWED,
+		BorrowedFrom: "library",
+	}
+	if _, err := books.SetOwnership(ctx, userID, borrowed); !errors.Is(err, storage.ErrLent) {
+		t.Fatalf("SetOwnership BORROWED while lent = %v, want %v", err, storage.ErrLent)
+	}
+
+	_, err = loans.Return(ctx, userID, &book_service.ReturnBookRequest{BookId: book.Id})
+	if err != nil {
+		t.Fatalf("Return: %v", err)
+	}
+
+	if _, err := books.SetOwnership(ctx, userID, borrowed); err != nil {
+		t.Fatalf("SetOwnership BORROWED: %v", err)
+	}
+	if n := countCopies(); n != 0 {
+		t.Fatalf("book given back has %d copies, want 0", n)
+	}
+}
+
+// TestDeleteLentBook keeps a lent book unless the delete is forced.
+func TestDeleteLentBook(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books := NewBookRepo(pool)
+
+	pk, err := books.Create(ctx, userID, &book_service.Book{
+		Isbn:  "9780306406157",
+		Title: "Mort",
+		Pages: 272,
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	_, err = NewLoanRepo(pool).Lend(ctx, userID, &book_service.LendBookRequest{BookId: pk.Id, Borrower: "Rincewind"})
+	if err != nil {
+		t.Fatalf("Lend: %v", err)
+	}
+
+	if err := books.Delete(ctx, userID, &book_service.DeleteBookRequest{Id: pk.Id}); !errors.Is(err, storage.ErrLent) {
+		t.Fatalf("Delete lent book = %v, want %v", err, storage.ErrLent)
+	}
+	if err := books.Delete(ctx, userID, &book_service.DeleteBookRequest{Id: pk.Id, Force: true}); err != nil {
+		t.Fatalf("Delete with force: %v", err)
+	}
+	if _, err := books.GetByPKey(ctx, userID, pk); !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("GetByPKey deleted book = %v, want %v", err, pgx.ErrNoRows)
+	}
+}
+
+// TestReturnBeforeLent gives a book back before it was lent out.
+func TestReturnBeforeLent(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	loans := NewLoanRepo(pool)
+
+	book, err := NewBookRepo(pool).Create(ctx, userID, &book_service.Book{
+		Isbn:  "9780306406157",
+		Title: "Mort",
+		Pages: 272,
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	_, err = loans.Lend(ctx, userID, &book_service.LendBookRequest{
+		BookId:   book.Id,
+		Borrower: "Rincewind",
+		LentAt:   "2024-03-10",
+	})
+	if err != nil {
+		t.Fatalf("Lend: %v", err)
+	}
+
+	_, err = loans.Return(ctx, userID, &book_service.ReturnBookRequest{
+		BookId:     book.Id,
+		ReturnedAt: "2024-03-09T12:00:00Z",
+	})
+	if !errors.Is(err, storage.ErrOutOfRange) {
+		t.Fatalf("Return before lent_at = %v, want %v", err, storage.ErrOutOfRange)
+	}
+
+	loan, err := loans.Return(ctx, userID, &book_service.ReturnBookRequest{
+		BookId:     book.Id,
+		ReturnedAt: "2024-03-10T18:00:00Z",
+	})
+	if err != nil {
+		t.Fatalf("Return on lent_at: %v", err)
+	}
+	if loan.ReturnedAt == "" {
+		t.Fatalf("loan = %v, want it returned", loan)
+	}
+}
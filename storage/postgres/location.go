@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"book/config"
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/storage"
+
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type LocationRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewLocationRepo(db *pgxpool.Pool) *LocationRepo {
+	return &LocationRepo{
+		db: db,
+	}
+}
+
+const locationColumns = `
+			lo."id",
+			lo."name",
+			lo."description",
+			(SELECT COUNT(*) FROM "copies" c WHERE c."location_id" = lo."id"),
+			TO_CHAR(lo."created_at", ` + config.DatabaseQueryTimeLayout + `)
+`
+
+func scanLocation(row rowScanner, dest ...interface{}) (*book_service.Location, error) {
+	var (
+		id          sql.NullInt32
+		name        sql.NullString
+		description sql.NullString
+		copyCount   sql.NullInt32
+		createdAt   sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&name,
+		&description,
+		&copyCount,
+		&createdAt,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &book_service.Location{
+		Id:          id.Int32,
+		Name:        name.String,
+		Description: description.String,
+		CopyCount:   copyCount.Int32,
+		CreatedAt:   createdAt.String,
+	}, nil
+}
+
+func (l *LocationRepo) Create(ctx context.Context, userID int32, req *book_service.CreateLocationRequest) (*book_service.Location, error) {
+	query := `
+		WITH lo AS (
+			INSERT INTO "locations" ("user_id", "name", "description") VALUES ($1, $2, $3)
+			RETURNING *
+		)
+		SELECT` + locationColumns + `
+		FROM lo
+	`
+
+	location, err := scanLocation(l.db.QueryRow(ctx, query, userID, req.Name, req.Description))
+	if isUniqueViolation(err) {
+		return nil, storage.ErrAlreadyExists
+	}
+
+	return location, err
+}
+
+func (l *LocationRepo) Update(ctx context.Context, userID int32, req *book_service.UpdateLocationRequest) (*book_service.Location, error) {
+	query := `
+		WITH lo AS (
+			UPDATE "locations"
+			SET
+				"name" = $3,
+				"description" = $4,
+				"updated_at" = NOW()
+			WHERE "id" = $1 AND "user_id" = $2
+			RETURNING *
+		)
+		SELECT` + locationColumns + `
+		FROM lo
+	`
+
+	location, err := scanLocation(l.db.QueryRow(ctx, query, req.Id, userID, req.Name, req.Description))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, storage.ErrAlreadyExists
+	}
+
+	return location, err
+}
+
+// Delete removes the location. Its copies stay, without a location.
+func (l *LocationRepo) Delete(ctx context.Context, userID int32, req *book_service.LocationPK) (int64, error) {
+	query := `DELETE FROM "locations" WHERE "id" = $1 AND "user_id" = $2`
+
+	result, err := l.db.Exec(ctx, query, req.Id, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (l *LocationRepo) GetAll(ctx context.Context, userID int32, req *book_service.LocationListRequest) (resp *book_service.LocationListResponse, err error) {
+	resp = &book_service.LocationListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = ` WHERE lo."user_id" = :user_id `
+		sort   = ` ORDER BY lo."name"`
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),` + locationColumns + `
+		FROM "locations" lo
+	`
+	params["user_id"] = userID
+	if len(req.GetSearch()) > 0 {
+		filter += ` AND lo."name" ILIKE '%' || :search || '%' `
+		params["search"] = req.Search
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := l.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		location, err := scanLocation(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Locations = append(resp.Locations, location)
+	}
+
+	return resp, rows.Err()
+}
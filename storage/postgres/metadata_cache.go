@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"book/models"
+	"book/storage"
+
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type MetadataCacheRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewMetadataCacheRepo(db *pgxpool.Pool) *MetadataCacheRepo {
+	return &MetadataCacheRepo{
+		db: db,
+	}
+}
+
+func (m *MetadataCacheRepo) Get(ctx context.Context, isbn string) (*models.MetadataCache, error) {
+	query := `
+		SELECT
+			"data",
+			"fetched_at",
+			"ttl_seconds"
+		FROM "book_metadata_cache"
+		WHERE "isbn" = $1
+	`
+
+	var (
+		data       []byte
+		fetchedAt  time.Time
+		ttlSeconds int32
+	)
+
+	err := m.db.QueryRow(ctx, query, isbn).Scan(&data, &fetchedAt, &ttlSeconds)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &models.MetadataCache{
+		Isbn:      isbn,
+		FetchedAt: fetchedAt,
+		TTL:       time.Duration(ttlSeconds) * time.Second,
+	}
+
+	if err := json.Unmarshal(data, &entry.Book); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// Upsert stores req.Book as fetched now. fetched_at is taken from the
+// database clock, the one GetStale compares it to, and req.FetchedAt is
+// ignored.
+func (m *MetadataCacheRepo) Upsert(ctx context.Context, req *models.MetadataCache) error {
+	query := `
+		INSERT INTO "book_metadata_cache" (
+			"isbn",
+			"data",
+			"fetched_at",
+			"ttl_seconds"
+		) VALUES ($1, $2::jsonb, NOW(), $3)
+		ON CONFLICT ("isbn") DO UPDATE SET
+			"data" = EXCLUDED."data",
+			"fetched_at" = EXCLUDED."fetched_at",
+			"ttl_seconds" = EXCLUDED."ttl_seconds",
+			"failures" = 0,
+			"retry_at" = NULL
+	`
+
+	data, err := json.Marshal(req.Book)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.db.Exec(ctx, query,
+		req.Isbn,
+		string(data),
+		int32(req.TTL/time.Second),
+	)
+
+	return err
+}
+
+// GetStale returns up to limit expired entries, oldest first, skipping the
+// ones still backing off after a failed refresh.
+func (m *MetadataCacheRepo) GetStale(ctx context.Context, limit int32) ([]string, error) {
+	query := `
+		SELECT "isbn"
+		FROM "book_metadata_cache"
+		WHERE "fetched_at" + "ttl_seconds" * INTERVAL '1 second' < NOW()
+			AND ("retry_at" IS NULL OR "retry_at" <= NOW())
+		ORDER BY "fetched_at"
+		LIMIT $1
+	`
+
+	rows, err := m.db.Query(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var isbns []string
+	for rows.Next() {
+		var isbn string
+		if err := rows.Scan(&isbn); err != nil {
+			return nil, err
+		}
+		isbns = append(isbns, isbn)
+	}
+
+	return isbns, rows.Err()
+}
+
+// MarkFailed records a failed refresh and holds the entry back from GetStale
+// for base, doubled with every consecutive failure, but never more than max.
+func (m *MetadataCacheRepo) MarkFailed(ctx context.Context, isbn string, base, max time.Duration) error {
+	query := `
+		UPDATE "book_metadata_cache"
+		SET
+			"retry_at" = NOW() + LEAST($2 * POWER(2, "failures"), $3) * INTERVAL '1 second',
+			"failures" = "failures" + 1
+		WHERE "isbn" = $1
+	`
+
+	_, err := m.db.Exec(ctx, query, isbn, base.Seconds(), max.Seconds())
+
+	return err
+}
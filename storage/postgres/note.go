@@ -0,0 +1,251 @@
+package postgres
+
+import (
+	"book/config"
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/storage"
+
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type NoteRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewNoteRepo(db *pgxpool.Pool) *NoteRepo {
+	return &NoteRepo{
+		db: db,
+	}
+}
+
+const noteColumns = `
+			n."id",
+			n."book_id",
+			(SELECT b."title" FROM "book" b WHERE b."id" = n."book_id"),
+			n."type",
+			n."text",
+			COALESCE(n."page", 0),
+			n."location",
+			n."shareable",
+			TO_CHAR(n."created_at", ` + config.DatabaseQueryTimeLayout + `),
+			COALESCE(TO_CHAR(n."updated_at", ` + config.DatabaseQueryTimeLayout + `), '')
+`
+
+func scanNote(row rowScanner, dest ...interface{}) (*book_service.Note, error) {
+	var (
+		id        sql.NullInt32
+		bookID    sql.NullInt32
+		bookTitle sql.NullString
+		noteType  sql.NullInt32
+		text      sql.NullString
+		page      sql.NullInt32
+		location  sql.NullString
+		shareable sql.NullBool
+		createdAt sql.NullString
+		updatedAt sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&bookID,
+		&bookTitle,
+		&noteType,
+		&text,
+		&page,
+		&location,
+		&shareable,
+		&createdAt,
+		&updatedAt,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &book_service.Note{
+		Id:        id.Int32,
+		BookId:    bookID.Int32,
+		BookTitle: bookTitle.String,
+		Type:      book_service.NoteType(noteType.Int32),
+		Text:      text.String,
+		Page:      page.Int32,
+		Location:  location.String,
+		Shareable: shareable.Bool,
+		CreatedAt: createdAt.String,
+		UpdatedAt: updatedAt.String,
+	}, nil
+}
+
+func (n *NoteRepo) Create(ctx context.Context, userID int32, req *book_service.CreateNoteRequest) (*book_service.Note, error) {
+	query := `
+		WITH n AS (
+			INSERT INTO "notes" (
+				"user_id",
+				"book_id",
+				"type",
+				"text",
+				"page",
+				"location",
+				"shareable"
+			)
+			SELECT $1, "id", $3, $4, NULLIF($5, 0), $6, $7
+			FROM "book"
+			WHERE "id" = $2 AND "user_id" = $1
+			RETURNING *
+		)
+		SELECT` + noteColumns + `
+		FROM n
+	`
+
+	note, err := scanNote(n.db.QueryRow(ctx, query,
+		userID,
+		req.BookId,
+		int32(req.Type),
+		req.Text,
+		req.Page,
+		req.Location,
+		req.Shareable,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+
+	return note, err
+}
+
+func (n *NoteRepo) GetByPKey(ctx context.Context, userID int32, req *book_service.NotePK) (*book_service.Note, error) {
+	query := `
+		SELECT` + noteColumns + `
+		FROM "notes" n
+		WHERE n."id" = $1 AND n."user_id" = $2
+	`
+
+	note, err := scanNote(n.db.QueryRow(ctx, query, req.Id, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+
+	return note, err
+}
+
+func (n *NoteRepo) Update(ctx context.Context, userID int32, req *book_service.UpdateNoteRequest) (*book_service.Note, error) {
+	query := `
+		WITH n AS (
+			UPDATE "notes"
+			SET
+				"type" = $3,
+				"text" = $4,
+				"page" = NULLIF($5, 0),
+				"location" = $6,
+				"shareable" = $7,
+				"updated_at" = NOW()
+			WHERE "id" = $1 AND "user_id" = $2
+			RETURNING *
+		)
+		SELECT` + noteColumns + `
+		FROM n
+	`
+
+	note, err := scanNote(n.db.QueryRow(ctx, query,
+		req.Id,
+		userID,
+		int32(req.Type),
+		req.Text,
+		req.Page,
+		req.Location,
+		req.Shareable,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+
+	return note, err
+}
+
+func (n *NoteRepo) Delete(ctx context.Context, userID int32, req *book_service.NotePK) (int64, error) {
+	query := `DELETE FROM "notes" WHERE "id" = $1 AND "user_id" = $2`
+
+	result, err := n.db.Exec(ctx, query, req.Id, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (n *NoteRepo) GetAll(ctx context.Context, userID int32, req *book_service.NoteListRequest) (resp *book_service.NoteListResponse, err error) {
+	resp = &book_service.NoteListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = ` WHERE n."user_id" = :user_id `
+		sort   = ` ORDER BY n."created_at" DESC, n."id" DESC`
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),` + noteColumns + `
+		FROM "notes" n
+	`
+	params["user_id"] = userID
+	if req.GetBookId() > 0 {
+		filter += ` AND n."book_id" = :book_id `
+		params["book_id"] = req.BookId
+	}
+	if len(req.GetTypes()) > 0 {
+		types := make([]int32, 0, len(req.Types))
+		for _, t := range req.Types {
+			types = append(types, int32(t))
+		}
+
+		filter += ` AND n."type" = ANY(:types) `
+		params["types"] = helper.Unique(types)
+	}
+	if len(req.GetFrom()) > 0 {
+		filter += ` AND n."created_at" >= :from::DATE `
+		params["from"] = req.From
+	}
+	if len(req.GetTo()) > 0 {
+		filter += ` AND n."created_at" < :to::DATE + 1 `
+		params["to"] = req.To
+	}
+	if len(req.GetSearch()) > 0 {
+		filter += ` AND n."text" ILIKE '%' || :search || '%' `
+		params["search"] = req.Search
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := n.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		note, err := scanNote(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Notes = append(resp.Notes, note)
+	}
+
+	return resp, rows.Err()
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/storage"
+
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestNoteFilters lists notes across books by type, text and creation date.
+func TestNoteFilters(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books, notes := NewBookRepo(pool), NewNoteRepo(pool)
+
+	ids := map[string]int32{}
+	for _, book := range []*book_service.Book{
+		{Isbn: "9780552131063", Title: "Small Gods"},
+		{Isbn: "9780552166591", Title: "The Colour of Magic"},
+	} {
+		pk, err := books.Create(ctx, userID, book)
+		if err != nil {
+			t.Fatalf("Create %s: %v", book.Title, err)
+		}
+		ids[book.Title] = pk.Id
+	}
+
+	for _, note := range []*book_service.CreateNoteRequest{
+		{BookId: ids["Small Gods"], Type: book_service.NoteType_QUOTE, Text: "Gods don't like people not doing much work."},
+		{BookId: ids["The Colour of Magic"], Type: book_service.NoteType_HIGHLIGHT, Text: "Great A'Tuin the turtle", Page: 3},
+		{BookId: ids["Small Gods"], Type: book_service.NoteType_NOTE, Text: "Om is a tortoise"},
+	} {
+		if _, err := notes.Create(ctx, userID, note); err != nil {
+			t.Fatalf("Create note %q: %v", note.Text, err)
+		}
+	}
+
+	_, err := notes.Create(ctx, userID, &book_service.CreateNoteRequest{Text: "no book"})
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("Create without a book = %v, want %v", err, storage.ErrNotFound)
+	}
+
+	today := time.Now().UTC().Format("2006-01-02")
+	yesterday := time.Now().UTC().AddDate(0, 0, -1).Format("2006-01-02")
+
+	for _, tc := range []struct {
+		name string
+		req  *book_service.NoteListRequest
+		want int
+	}{
+		{"quotes and highlights", &book_service.NoteListRequest{Types: []book_service.NoteType{book_service.NoteType_QUOTE, book_service.NoteType_HIGHLIGHT}}, 2},
+		{"turtles", &book_service.NoteListRequest{Search: "TURTLE"}, 1},
+		{"Small Gods", &book_service.NoteListRequest{BookId: ids["Small Gods"]}, 2},
+		{"today", &book_service.NoteListRequest{From: today, To: today}, 3},
+		{"until yesterday", &book_service.NoteListRequest{To: yesterday}, 0},
+	} {
+		resp, err := notes.GetAll(ctx, userID, tc.req)
+		if err != nil {
+			t.Fatalf("GetAll %s: %v", tc.name, err)
+		}
+		if len(resp.Notes) != tc.want {
+			t.Fatalf("%s = %d notes, want %d", tc.name, len(resp.Notes), tc.want)
+		}
+	}
+}
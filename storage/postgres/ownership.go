@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/storage"
+
+	"context"
+)
+
+// SetOwnership moves the book to req.Ownership. Copies are kept for owned
+// books only: an owned book gets its first copy and a book that stops being
+// ours loses its copies, which storage.ErrLent refuses while one is lent out.
+func (u *BookRepo) SetOwnership(ctx context.Context, userID int32, req *book_service.SetOwnershipRequest) (int64, error) {
+	query := `
+		UPDATE "book"
+		SET
+			"ownership" = $3,
+			"borrowed_from" = $4,
+			"return_by" = $5::DATE,
+			"priority" = $6,
+			"where_to_buy" = $7,
+			"updated_at" = NOW()
+		WHERE "id" = $1 AND "user_id" = $2
+	`
+
+	// details of the other ownership states are dropped
+	var (
+		borrowedFrom, returnBy, whereToBuy string
+		priority                           int32
+	)
+	switch req.Ownership {
+	case book_service.Ownership_BORROWED:
+		borrowedFrom, returnBy = req.BorrowedFrom, req.ReturnBy
+	case book_service.Ownership_WISHLIST:
+		priority, whereToBuy = req.Priority, req.WhereToBuy
+	}
+
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	if req.Ownership != book_service.Ownership_OWNED {
+		var lent bool
+		err = tx.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM "loans"
+				WHERE "book_id" = $1 AND "user_id" = $2 AND "returned_at" IS NULL
+			)
+		`, req.BookId, userID).Scan(&lent)
+		if err != nil {
+			return 0, err
+		}
+		if lent {
+			return 0, storage.ErrLent
+		}
+	}
+
+	result, err := tx.Exec(ctx, query,
+		req.BookId,
+		userID,
+		int32(req.Ownership),
+		borrowedFrom,
+		helper.NewNullString(returnBy),
+		priority,
+		whereToBuy,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if result.RowsAffected() > 0 {
+		if req.Ownership == book_service.Ownership_OWNED {
+			err = addFirstCopy(ctx, tx, userID, req.BookId)
+		} else {
+			_, err = tx.Exec(ctx, `DELETE FROM "copies" WHERE "book_id" = $1 AND "user_id" = $2`, req.BookId, userID)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// GetDueSoon lists borrowed books due back within req.Days, overdue ones
+// first.
+func (u *BookRepo) GetDueSoon(ctx context.Context, userID int32, req *book_service.DueSoonRequest) (resp *book_service.BookListResponse, err error) {
+	resp = &book_service.BookListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),` + bookColumns + `
+		FROM "book"
+		WHERE "user_id" = :user_id AND "ownership" = 1
+			AND "return_by" <= CURRENT_DATE + :days::INTEGER
+		ORDER BY "return_by", "book"."id"
+	`
+	params["user_id"] = userID
+	params["days"] = req.Days
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := u.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		book, err := scanBook(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Books = append(resp.Books, book)
+	}
+
+	return resp, rows.Err()
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+
+	"context"
+	"testing"
+	"time"
+)
+
+// TestDueSoon lists the borrowed books due within a week, the overdue one
+// first, and forgets the return date of a book once it is ours.
+func TestDueSoon(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books := NewBookRepo(pool)
+
+	day := func(days int) string {
+		return time.Now().UTC().AddDate(0, 0, days).Format("2006-01-02")
+	}
+
+	ids := map[string]int32{}
+	for _, book := range []*book_service.Book{
+		{Isbn: "9780552131063", Title: "Small Gods", Ownership: book_service.Ownership_BORROWED, BorrowedFrom: "library", ReturnBy: day(5)},
+		{Isbn: "9780552134644", Title: "Pyramids", Ownership: book_service.Ownership_BORROWED, BorrowedFrom: "library", ReturnBy: day(-3)},
+		{Isbn: "9780552166591", Title: "The Colour of Magic", Ownership: book_service.Ownership_BORROWED, BorrowedFrom: "Ridcully", ReturnBy: day(20)},
+		{Isbn: "9780552166607", Title: "The Light Fantastic"},
+	} {
+		pk, err := books.Create(ctx, userID, book)
+		if err != nil {
+			t.Fatalf("Create %s: %v", book.Title, err)
+		}
+		ids[book.Title] = pk.Id
+	}
+
+	resp, err := books.GetDueSoon(ctx, userID, &book_service.DueSoonRequest{Days: 7})
+	if err != nil {
+		t.Fatalf("GetDueSoon: %v", err)
+	}
+	if len(resp.Books) != 2 || resp.Books[0].Id != ids["Pyramids"] || resp.Books[1].Id != ids["Small Gods"] {
+		t.Fatalf("due soon = %v, want Pyramids then Small Gods", resp.Books)
+	}
+
+	_, err = books.SetOwnership(ctx, userID, &book_service.SetOwnershipRequest{
+		BookId:    ids["Pyramids"],
+		Ownership: book_service.Ownership_OWNED,
+		ReturnBy:  day(1),
+	})
+	if err != nil {
+		t.Fatalf("SetOwnership: %v", err)
+	}
+
+	book, err := books.GetByPKey(ctx, userID, &book_service.BookPK{Id: ids["Pyramids"]})
+	if err != nil {
+		t.Fatalf("GetByPKey: %v", err)
+	}
+	if book.BorrowedFrom != "" || book.ReturnBy != "" {
+		t.Fatalf("owned book borrowed from %q until %q, want neither", book.BorrowedFrom, book.ReturnBy)
+	}
+}
+
+// TestWishlist keeps wishlist books out of the default list, sorts them by
+// priority and gives a bought one a copy of its own.
+func TestWishlist(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books := NewBookRepo(pool)
+
+	ids := map[string]int32{}
+	for _, book := range []*book_service.Book{
+		{Isbn: "9780552131063", Title: "Small Gods"},
+		{Isbn: "9780552134644", Title: "Pyramids", Ownership: book_service.Ownership_WISHLIST, Priority: 1},
+		{Isbn: "9780552166591", Title: "The Colour of Magic", Ownership: book_service.Ownership_WISHLIST, Priority: 5, WhereToBuy: "Ankh-Morpork"},
+	} {
+		pk, err := books.Create(ctx, userID, book)
+		if err != nil {
+			t.Fatalf("Create %s: %v", book.Title, err)
+		}
+		ids[book.Title] = pk.Id
+	}
+
+	all, err := books.GetAll(ctx, userID, &book_service.BookListRequest{})
+	if err != nil {
+		t.Fatalf("GetAll: %v", err)
+	}
+	if len(all.Books) != 1 || all.Books[0].Id != ids["Small Gods"] {
+		t.Fatalf("books = %v, want Small Gods only", all.Books)
+	}
+
+	wishlist, err := books.GetAll(ctx, userID, &book_service.BookListRequest{
+		Wishlist: book_service.WishlistFilter_WISHLIST_ONLY,
+		SortBy:   "priority",
+	})
+	if err != nil {
+		t.Fatalf("GetAll wishlist: %v", err)
+	}
+	if len(wishlist.Books) != 2 || wishlist.Books[0].Id != ids["The Colour of Magic"] || wishlist.Books[1].Id != ids["Pyramids"] {
+		t.Fatalf("wishlist = %v, want The Colour of Magic then Pyramids", wishlist.Books)
+	}
+
+	bought := &book_service.BookPK{Id: ids["The Colour of Magic"]}
+	_, err = books.SetOwnership(ctx, userID, &book_service.SetOwnershipRequest{BookId: bought.Id, Ownership: book_service.Ownership_OWNED})
+	if err != nil {
+		t.Fatalf("SetOwnership: %v", err)
+	}
+
+	book, err := books.GetByPKey(ctx, userID, bought)
+	if err != nil {
+		t.Fatalf("GetByPKey: %v", err)
+	}
+	if book.Ownership != book_service.Ownership_OWNED || book.Priority != 0 || book.WhereToBuy != "" {
+		t.Fatalf("bought book = %v, want owned without wishlist details", book)
+	}
+
+	copies, err := NewCopyRepo(pool).GetAll(ctx, userID, &book_service.CopyListRequest{BookId: bought.Id})
+	if err != nil {
+		t.Fatalf("GetAll copies: %v", err)
+	}
+	if copies.Count != 1 {
+		t.Fatalf("bought book has %d copies, want 1", copies.Count)
+	}
+}
@@ -5,21 +5,55 @@ import (
 	"book/storage"
 
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
+const (
+	pgUniqueViolation = "23505"
+	pgCheckViolation  = "23514"
+)
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation
+}
+
+func isCheckViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgCheckViolation
+}
+
 type Store struct {
-	db   *pgxpool.Pool
-	book storage.BookRepoI
+	db            *pgxpool.Pool
+	book          storage.BookRepoI
+	metadataCache storage.MetadataCacheRepoI
+	author        storage.AuthorRepoI
+	session       storage.SessionRepoI
+	review        storage.ReviewRepoI
+	tag           storage.TagRepoI
+	shelf         storage.ShelfRepoI
+	goal          storage.GoalRepoI
+	stats         storage.StatsRepoI
+	note          storage.NoteRepoI
+	loan          storage.LoanRepoI
+	series        storage.SeriesRepoI
+	work          storage.WorkRepoI
+	location      storage.LocationRepoI
+	copy          storage.CopyRepoI
+	read          storage.ReadRepoI
 }
 
 func NewPostgres(ctx context.Context, cfg config.Config) (storage.StorageI, error) {
+	// the timestamp columns have no zone and hold UTC, so NOW() and
+	// CURRENT_TIMESTAMP have to be UTC too
 	config, err := pgxpool.ParseConfig(fmt.Sprintf(
-		"postgres://%s:%s@%s:%d/%s?sslmode=disable",
+		"postgres://%s:%s@%s:%d/%s?sslmode=disable&timezone=UTC",
 		cfg.PostgresUser,
 		cfg.PostgresPassword,
 		cfg.PostgresHost,
@@ -38,8 +72,23 @@ func NewPostgres(ctx context.Context, cfg config.Config) (storage.StorageI, erro
 	}
 
 	return &Store{
-		db:   pool,
-		book: NewBookRepo(pool),
+		db:            pool,
+		book:          NewBookRepo(pool),
+		metadataCache: NewMetadataCacheRepo(pool),
+		author:        NewAuthorRepo(pool),
+		session:       NewSessionRepo(pool),
+		review:        NewReviewRepo(pool),
+		tag:           NewTagRepo(pool),
+		shelf:         NewShelfRepo(pool),
+		goal:          NewGoalRepo(pool),
+		stats:         NewStatsRepo(pool),
+		note:          NewNoteRepo(pool),
+		loan:          NewLoanRepo(pool),
+		series:        NewSeriesRepo(pool),
+		work:          NewWorkRepo(pool),
+		location:      NewLocationRepo(pool),
+		copy:          NewCopyRepo(pool),
+		read:          NewReadRepo(pool),
 	}, nil
 }
 
@@ -54,6 +103,111 @@ func (s *Store) Book() storage.BookRepoI {
 	return s.book
 }
 
+func (s *Store) MetadataCache() storage.MetadataCacheRepoI {
+	if s.metadataCache == nil {
+		s.metadataCache = NewMetadataCacheRepo(s.db)
+	}
+	return s.metadataCache
+}
+
+func (s *Store) Author() storage.AuthorRepoI {
+	if s.author == nil {
+		s.author = NewAuthorRepo(s.db)
+	}
+	return s.author
+}
+
+func (s *Store) Session() storage.SessionRepoI {
+	if s.session == nil {
+		s.session = NewSessionRepo(s.db)
+	}
+	return s.session
+}
+
+func (s *Store) Review() storage.ReviewRepoI {
+	if s.review == nil {
+		s.review = NewReviewRepo(s.db)
+	}
+	return s.review
+}
+
+func (s *Store) Tag() storage.TagRepoI {
+	if s.tag == nil {
+		s.tag = NewTagRepo(s.db)
+	}
+	return s.tag
+}
+
+func (s *Store) Shelf() storage.ShelfRepoI {
+	if s.shelf == nil {
+		s.shelf = NewShelfRepo(s.db)
+	}
+	return s.shelf
+}
+
+func (s *Store) Goal() storage.GoalRepoI {
+	if s.goal == nil {
+		s.goal = NewGoalRepo(s.db)
+	}
+	return s.goal
+}
+
+func (s *Store) Stats() storage.StatsRepoI {
+	if s.stats == nil {
+		s.stats = NewStatsRepo(s.db)
+	}
+	return s.stats
+}
+
+func (s *Store) Note() storage.NoteRepoI {
+	if s.note == nil {
+		s.note = NewNoteRepo(s.db)
+	}
+	return s.note
+}
+
+func (s *Store) Loan() storage.LoanRepoI {
+	if s.loan == nil {
+		s.loan = NewLoanRepo(s.db)
+	}
+	return s.loan
+}
+
+func (s *Store) Series() storage.SeriesRepoI {
+	if s.series == nil {
+		s.series = NewSeriesRepo(s.db)
+	}
+	return s.series
+}
+
+func (s *Store) Work() storage.WorkRepoI {
+	if s.work == nil {
+		s.work = NewWorkRepo(s.db)
+	}
+	return s.work
+}
+
+func (s *Store) Location() storage.LocationRepoI {
+	if s.location == nil {
+		s.location = NewLocationRepo(s.db)
+	}
+	return s.location
+}
+
+func (s *Store) Copy() storage.CopyRepoI {
+	if s.copy == nil {
+		s.copy = NewCopyRepo(s.db)
+	}
+	return s.copy
+}
+
+func (s *Store) Read() storage.ReadRepoI {
+	if s.read == nil {
+		s.read = NewReadRepo(s.db)
+	}
+	return s.read
+}
+
 func (l *Store) Log(ctx context.Context, level pgx.LogLevel, msg string, data map[string]interface{}) {
 	args := make([]interface{}, 0, len(data)+2) // making space for arguments + level + msg
 	args = append(args, level, msg)
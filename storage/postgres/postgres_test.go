@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// The storage tests need a migrated database, named by TEST_DATABASE_URL,
+// and are skipped without one; `make test-db` runs them locally and the test
+// workflow in CI.
+
+// newTestPool connects to the test database and returns a user id no other
+// test uses. Every row of that user is removed when the test ends.
+func newTestPool(t *testing.T) (*pgxpool.Pool, int32) {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	ctx := context.Background()
+
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("parse TEST_DATABASE_URL: %v", err)
+	}
+	cfg.ConnConfig.RuntimeParams["timezone"] = "UTC"
+
+	pool, err := pgxpool.ConnectConfig(ctx, cfg)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	userID := 1e9 + rand.Int31n(1e9)
+	t.Cleanup(func() {
+		defer pool.Close()
+
+		if err := deleteTestUser(ctx, pool, userID); err != nil {
+			t.Errorf("clean up user %d: %v", userID, err)
+		}
+	})
+
+	return pool, userID
+}
+
+// deleteTestUser deletes the user's rows from every table with a user_id,
+// books first so the rows hanging off them go with them. Authors are shared,
+// so those left without a book go too.
+func deleteTestUser(ctx context.Context, pool *pgxpool.Pool, userID int32) error {
+	rows, err := pool.Query(ctx, `
+		SELECT "table_name"::TEXT FROM information_schema.columns
+		WHERE "table_schema" = CURRENT_SCHEMA() AND "column_name" = 'user_id'
+		ORDER BY "table_name" <> 'book', "table_name"
+	`)
+	if err != nil {
+		return err
+	}
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			rows.Close()
+			return err
+		}
+		tables = append(tables, table)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, table := range tables {
+		_, err := pool.Exec(ctx, `DELETE FROM "`+table+`" WHERE "user_id" = $1`, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = pool.Exec(ctx, `
+		DELETE FROM "authors" a
+		WHERE NOT EXISTS (SELECT 1 FROM "book_authors" ba WHERE ba."author_id" = a."id")
+	`)
+
+	return err
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/storage"
+
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// bookProgressColumns selects the latest recorded page and percentage of the
+// current read of "book". Finished books without progress rows count as fully
+// read.
+const bookProgressColumns = `
+			COALESCE((
+				SELECT p."page" FROM "book_progress" p
+				WHERE p."book_id" = "book"."id" AND p."read_id" = ` + bookCurrentReadExpr + `
+				ORDER BY p."created_at" DESC, p."id" DESC
+				LIMIT 1
+			), CASE WHEN "book"."status" = 2 THEN "book"."pages" ELSE 0 END),
+			COALESCE((
+				SELECT p."percent"::FLOAT8 FROM "book_progress" p
+				WHERE p."book_id" = "book"."id" AND p."read_id" = ` + bookCurrentReadExpr + `
+				ORDER BY p."created_at" DESC, p."id" DESC
+				LIMIT 1
+			), CASE WHEN "book"."status" = 2 THEN 100 ELSE 0 END)`
+
+// UpdateProgress records progress on the book's current read. A FINISHED book
+// has none, so it returns ErrInvalidTransition until ReadRepo.Start opens a
+// new read.
+func (u *BookRepo) UpdateProgress(ctx context.Context, userID int32, req *book_service.UpdateProgressRequest) (rowsAffected int64, err error) {
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		pages      int32
+		bookStatus int32
+		page       *int32
+		percent    float64
+	)
+
+	err = tx.QueryRow(ctx, `
+		SELECT "pages", "status" FROM "book" WHERE "id" = $1 AND "user_id" = $2 FOR UPDATE
+	`, req.BookId, userID).Scan(&pages, &bookStatus)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	switch progress := req.Progress.(type) {
+	case *book_service.UpdateProgressRequest_Page:
+		if progress.Page < 0 || (pages > 0 && progress.Page > pages) {
+			return 0, fmt.Errorf("%w: page %d is outside 0..%d", storage.ErrOutOfRange, progress.Page, pages)
+		}
+		page = &progress.Page
+		if pages > 0 {
+			percent = float64(progress.Page) * 100 / float64(pages)
+		}
+	case *book_service.UpdateProgressRequest_Percent:
+		if progress.Percent < 0 || progress.Percent > 100 {
+			return 0, fmt.Errorf("%w: percent %.2f is outside 0..100", storage.ErrOutOfRange, progress.Percent)
+		}
+		percent = float64(progress.Percent)
+		if pages > 0 {
+			p := int32(math.Round(percent * float64(pages) / 100))
+			page = &p
+		}
+	default:
+		return 0, fmt.Errorf("%w: page or percent is required", storage.ErrOutOfRange)
+	}
+
+	current := book_service.BookStatus(bookStatus)
+	finished := percent >= 100
+
+	if current == book_service.BookStatus_FINISHED {
+		return 0, fmt.Errorf("%w: the book is finished, start a new read first", storage.ErrInvalidTransition)
+	}
+
+	// progress belongs to a read, so progress on a new book starts one, and a
+	// book being read without an open read, like an edition that inherited
+	// READING before it opened its own, gets one
+	switch current {
+	case book_service.BookStatus_NEW:
+		if _, err := changeStatus(ctx, tx, userID, req.BookId, book_service.BookStatus_READING); err != nil {
+			return 0, err
+		}
+		current = book_service.BookStatus_READING
+	case book_service.BookStatus_READING:
+		if err := syncRead(ctx, tx, req.BookId, book_service.BookStatus_READING); err != nil {
+			return 0, err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO "book_progress" ("book_id", "read_id", "page", "percent")
+		SELECT $1, (
+			SELECT "id" FROM "reads" WHERE "book_id" = $1
+			ORDER BY "started_at" DESC, "id" DESC
+			LIMIT 1
+		), $2::INTEGER, $3::NUMERIC
+	`, req.BookId, page, math.Round(percent*100)/100)
+	if err != nil {
+		return 0, err
+	}
+
+	if current == book_service.BookStatus_READING && finished {
+		if _, err := changeStatus(ctx, tx, userID, req.BookId, book_service.BookStatus_FINISHED); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return 1, nil
+}
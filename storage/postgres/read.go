@@ -0,0 +1,299 @@
+package postgres
+
+import (
+	"book/config"
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/storage"
+
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type ReadRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewReadRepo(db *pgxpool.Pool) *ReadRepo {
+	return &ReadRepo{
+		db: db,
+	}
+}
+
+// bookCurrentReadExpr is the id of the latest read of "book", NULL when it
+// has never been started.
+const bookCurrentReadExpr = `(
+				SELECT r."id" FROM "reads" r
+				WHERE r."book_id" = "book"."id"
+				ORDER BY r."started_at" DESC, r."id" DESC
+				LIMIT 1
+			)`
+
+// bookReadCountColumn counts the finished read-throughs of "book".
+const bookReadCountColumn = `
+			(SELECT COUNT(*) FROM "reads" r WHERE r."book_id" = "book"."id" AND r."finished_at" IS NOT NULL AND NOT r."dnf")`
+
+// readCountedCond holds for a read r of "book" that stats and goals count: it
+// was finished, not abandoned, and it is the work's read-through rather than
+// another edition's share of it. Finished reads of a work's editions that
+// overlap in time are one read-through, counted on the earliest edition.
+const readCountedCond = `
+			r."finished_at" IS NOT NULL AND NOT r."dnf"
+			AND NOT EXISTS (
+				SELECT 1 FROM "reads" o
+				JOIN "book" e ON e."id" = o."book_id"
+				WHERE e."work_id" = "book"."work_id" AND e."id" < "book"."id"
+					AND o."finished_at" IS NOT NULL AND NOT o."dnf"
+					AND o."started_at" <= r."finished_at" AND o."finished_at" >= r."started_at"
+			)`
+
+// readColumns selects read r. A finished read without progress rows counts
+// as fully read.
+const readColumns = `
+			r."id",
+			r."book_id",
+			(SELECT COUNT(*) FROM "reads" o WHERE o."book_id" = r."book_id" AND (o."started_at", o."id") <= (r."started_at", r."id")),
+			TO_CHAR(r."started_at", ` + config.DatabaseQueryTimeLayout + `),
+			COALESCE(TO_CHAR(r."finished_at", ` + config.DatabaseQueryTimeLayout + `), ''),
+			r."dnf",
+			COALESCE((
+				SELECT p."page" FROM "book_progress" p
+				WHERE p."read_id" = r."id"
+				ORDER BY p."created_at" DESC, p."id" DESC
+				LIMIT 1
+			), CASE WHEN r."finished_at" IS NOT NULL AND NOT r."dnf" THEN (SELECT b."pages" FROM "book" b WHERE b."id" = r."book_id") ELSE 0 END),
+			COALESCE((
+				SELECT p."percent"::FLOAT8 FROM "book_progress" p
+				WHERE p."read_id" = r."id"
+				ORDER BY p."created_at" DESC, p."id" DESC
+				LIMIT 1
+			), CASE WHEN r."finished_at" IS NOT NULL AND NOT r."dnf" THEN 100 ELSE 0 END)
+`
+
+func scanRead(row rowScanner, dest ...interface{}) (*book_service.Read, error) {
+	var (
+		id          sql.NullInt32
+		bookID      sql.NullInt32
+		number      sql.NullInt32
+		startedAt   sql.NullString
+		finishedAt  sql.NullString
+		dnf         sql.NullBool
+		currentPage sql.NullInt32
+		percent     sql.NullFloat64
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&bookID,
+		&number,
+		&startedAt,
+		&finishedAt,
+		&dnf,
+		&currentPage,
+		&percent,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &book_service.Read{
+		Id:              id.Int32,
+		BookId:          bookID.Int32,
+		Number:          number.Int32,
+		StartedAt:       startedAt.String,
+		FinishedAt:      finishedAt.String,
+		Dnf:             dnf.Bool,
+		CurrentPage:     currentPage.Int32,
+		PercentComplete: float32(percent.Float64),
+	}, nil
+}
+
+// syncRead keeps the reads of a book in step with a status change: starting
+// to read opens a read, finishing closes it and going back to NEW abandons it.
+func syncRead(ctx context.Context, tx pgx.Tx, bookID int32, to book_service.BookStatus) error {
+	var query string
+	switch to {
+	case book_service.BookStatus_READING:
+		query = `
+			INSERT INTO "reads" ("book_id") VALUES ($1)
+			ON CONFLICT ("book_id") WHERE "finished_at" IS NULL DO NOTHING
+		`
+	case book_service.BookStatus_FINISHED:
+		query = `UPDATE "reads" SET "finished_at" = NOW() WHERE "book_id" = $1 AND "finished_at" IS NULL`
+	default:
+		query = `UPDATE "reads" SET "finished_at" = NOW(), "dnf" = TRUE WHERE "book_id" = $1 AND "finished_at" IS NULL`
+	}
+
+	_, err := tx.Exec(ctx, query, bookID)
+
+	return err
+}
+
+// lockBookStatus locks the user's book for the rest of tx and returns its
+// status.
+func lockBookStatus(ctx context.Context, tx pgx.Tx, userID, bookID int32) (book_service.BookStatus, error) {
+	var bookStatus int32
+
+	err := tx.QueryRow(ctx, `
+		SELECT "status" FROM "book" WHERE "id" = $1 AND "user_id" = $2 FOR UPDATE
+	`, bookID, userID).Scan(&bookStatus)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, storage.ErrNotFound
+	}
+
+	return book_service.BookStatus(bookStatus), err
+}
+
+// Start opens a new read of a finished book and moves it back to READING.
+func (r *ReadRepo) Start(ctx context.Context, userID int32, req *book_service.BookPK) (*book_service.Read, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	current, err := lockBookStatus(ctx, tx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if current != book_service.BookStatus_FINISHED {
+		return nil, fmt.Errorf("%w: only finished books can be reread", storage.ErrInvalidTransition)
+	}
+
+	if _, err := changeStatus(ctx, tx, userID, req.Id, book_service.BookStatus_READING); err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT` + readColumns + `
+		FROM "reads" r
+		WHERE r."book_id" = $1 AND r."finished_at" IS NULL
+	`
+
+	read, err := scanRead(tx.QueryRow(ctx, query, req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return read, nil
+}
+
+// Abandon marks the read in progress as DNF. The book goes back to FINISHED
+// when an earlier read was finished, and to NEW otherwise; either way the
+// status history marks the change as DNF rather than a finish.
+func (r *ReadRepo) Abandon(ctx context.Context, userID int32, req *book_service.BookPK) (*book_service.Read, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := lockBookStatus(ctx, tx, userID, req.Id); err != nil {
+		return nil, err
+	}
+
+	var (
+		readID   int32
+		readOnce bool
+	)
+	err = tx.QueryRow(ctx, `
+		UPDATE "reads" SET "finished_at" = NOW(), "dnf" = TRUE
+		WHERE "book_id" = $1 AND "finished_at" IS NULL
+		RETURNING "id", EXISTS (
+			SELECT 1 FROM "reads" o WHERE o."book_id" = $1 AND o."finished_at" IS NOT NULL AND NOT o."dnf"
+		)
+	`, req.Id).Scan(&readID, &readOnce)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	to := book_service.BookStatus_NEW
+	if readOnce {
+		to = book_service.BookStatus_FINISHED
+	}
+	if _, err := recordStatus(ctx, tx, userID, req.Id, to, true); err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT` + readColumns + `
+		FROM "reads" r
+		WHERE r."id" = $1
+	`
+
+	read, err := scanRead(tx.QueryRow(ctx, query, readID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return read, nil
+}
+
+func (r *ReadRepo) GetAll(ctx context.Context, userID int32, req *book_service.ReadListRequest) (resp *book_service.ReadListResponse, err error) {
+	resp = &book_service.ReadListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = ` WHERE b."user_id" = :user_id `
+		sort   = ` ORDER BY r."started_at", r."id"`
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),` + readColumns + `
+		FROM "reads" r
+		JOIN "book" b ON b."id" = r."book_id"
+	`
+	params["user_id"] = userID
+	if req.GetBookId() > 0 {
+		filter += ` AND r."book_id" = :book_id `
+		params["book_id"] = req.BookId
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		read, err := scanRead(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Reads = append(resp.Reads, read)
+	}
+
+	return resp, rows.Err()
+}
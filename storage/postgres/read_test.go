@@ -0,0 +1,268 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/storage"
+
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestInheritedReadingOpensRead adds a second edition of a work that is being
+// read, so the edition inherits READING, and reads it to the end.
+func TestInheritedReadingOpensRead(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books := NewBookRepo(pool)
+
+	first, err := books.Create(ctx, userID, &book_service.Book{
+		Isbn:    "9780306406157",
+		Title:   "Guards! Guards!",
+		Pages:   288,
+		Status:  book_service.BookStatus_READING,
+		WorkKey: "OL453936W",
+	})
+	if err != nil {
+		t.Fatalf("Create first edition: %v", err)
+	}
+
+	second, err := books.Create(ctx, userID, &book_service.Book{
+		Isbn:    "9780552166676",
+		Title:   "Guards! Guards!",
+		Pages:   400,
+		WorkKey: "OL453936W",
+	})
+	if err != nil {
+		t.Fatalf("Create second edition: %v", err)
+	}
+
+	reads, err := NewReadRepo(pool).GetAll(ctx, userID, &book_service.ReadListRequest{BookId: second.Id})
+	if err != nil {
+		t.Fatalf("GetAll reads: %v", err)
+	}
+	if len(reads.Reads) != 1 || reads.Reads[0].FinishedAt != "" {
+		t.Fatalf("reads of the second edition = %v, want one open read", reads.Reads)
+	}
+
+	_, err = books.UpdateProgress(ctx, userID, &book_service.UpdateProgressRequest{
+		BookId:   second.Id,
+		Progress: &book_service.UpdateProgressRequest_Page{Page: 100},
+	})
+	if err != nil {
+		t.Fatalf("UpdateProgress: %v", err)
+	}
+
+	book, err := books.GetByPKey(ctx, userID, second)
+	if err != nil {
+		t.Fatalf("GetByPKey: %v", err)
+	}
+	if book.Status != book_service.BookStatus_READING || book.CurrentPage != 100 {
+		t.Fatalf("second edition = %v page %d, want READING page 100", book.Status, book.CurrentPage)
+	}
+
+	_, err = books.UpdateProgress(ctx, userID, &book_service.UpdateProgressRequest{
+		BookId:   second.Id,
+		Progress: &book_service.UpdateProgressRequest_Page{Page: 400},
+	})
+	if err != nil {
+		t.Fatalf("UpdateProgress: %v", err)
+	}
+
+	book, err = books.GetByPKey(ctx, userID, second)
+	if err != nil {
+		t.Fatalf("GetByPKey: %v", err)
+	}
+	if book.Status != book_service.BookStatus_FINISHED || book.ReadCount != 1 {
+		t.Fatalf("second edition = %v with %d reads, want FINISHED with 1", book.Status, book.ReadCount)
+	}
+
+	book, err = books.GetByPKey(ctx, userID, first)
+	if err != nil {
+		t.Fatalf("GetByPKey: %v", err)
+	}
+	if book.ReadCount != 0 {
+		t.Fatalf("first edition has %d finished reads, want 0", book.ReadCount)
+	}
+}
+
+// TestProgressOpensMissingRead covers editions that inherited READING before
+// Create opened a read for them.
+func TestProgressOpensMissingRead(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books := NewBookRepo(pool)
+
+	pk, err := books.Create(ctx, userID, &book_service.Book{
+		Isbn:   "9780306406157",
+		Title:  "Guards! Guards!",
+		Pages:  288,
+		Status: book_service.BookStatus_READING,
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if _, err := pool.Exec(ctx, `DELETE FROM "reads" WHERE "book_id" = $1`, pk.Id); err != nil {
+		t.Fatalf("delete reads: %v", err)
+	}
+
+	_, err = books.UpdateProgress(ctx, userID, &book_service.UpdateProgressRequest{
+		BookId:   pk.Id,
+		Progress: &book_service.UpdateProgressRequest_Page{Page: 50},
+	})
+	if err != nil {
+		t.Fatalf("UpdateProgress: %v", err)
+	}
+
+	book, err := books.GetByPKey(ctx, userID, pk)
+	if err != nil {
+		t.Fatalf("GetByPKey: %v", err)
+	}
+	if book.CurrentPage != 50 {
+		t.Fatalf("current page = %d, want 50", book.CurrentPage)
+	}
+}
+
+// TestEditionsReadTogetherCountOnce finishes two editions of a work read side
+// by side at different times, which stats must count as one read-through.
+func TestEditionsReadTogetherCountOnce(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books := NewBookRepo(pool)
+
+	first, err := books.Create(ctx, userID, &book_service.Book{
+		Isbn:    "9780306406157",
+		Title:   "Guards! Guards!",
+		Pages:   288,
+		Status:  book_service.BookStatus_READING,
+		WorkKey: "OL453936W",
+	})
+	if err != nil {
+		t.Fatalf("Create first edition: %v", err)
+	}
+
+	second, err := books.Create(ctx, userID, &book_service.Book{
+		Isbn:    "9780552166676",
+		Title:   "Guards! Guards!",
+		Pages:   400,
+		WorkKey: "OL453936W",
+	})
+	if err != nil {
+		t.Fatalf("Create second edition: %v", err)
+	}
+
+	for _, edition := range []struct {
+		pk    *book_service.BookPK
+		pages int32
+	}{{second, 400}, {first, 288}} {
+		_, err = books.UpdateProgress(ctx, userID, &book_service.UpdateProgressRequest{
+			BookId:   edition.pk.Id,
+			Progress: &book_service.UpdateProgressRequest_Page{Page: edition.pages},
+		})
+		if err != nil {
+			t.Fatalf("UpdateProgress %d: %v", edition.pk.Id, err)
+		}
+	}
+
+	stats, err := NewStatsRepo(pool).Get(ctx, userID, &book_service.StatsRequest{})
+	if err != nil {
+		t.Fatalf("Get stats: %v", err)
+	}
+	if stats.BooksFinished != 1 || stats.PagesRead != 288 {
+		t.Fatalf("stats = %d books, %d pages, want 1 book, 288 pages", stats.BooksFinished, stats.PagesRead)
+	}
+}
+
+// TestAbandonedRereadIsNoFinish gives up a reread, which takes the book back
+// to FINISHED without the history recording another finish.
+func TestAbandonedRereadIsNoFinish(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books, reads := NewBookRepo(pool), NewReadRepo(pool)
+
+	pk, err := books.Create(ctx, userID, &book_service.Book{
+		Isbn:   "9780306406157",
+		Title:  "Guards! Guards!",
+		Pages:  288,
+		Status: book_service.BookStatus_FINISHED,
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if _, err := reads.Start(ctx, userID, pk); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	read, err := reads.Abandon(ctx, userID, pk)
+	if err != nil {
+		t.Fatalf("Abandon: %v", err)
+	}
+	if !read.Dnf {
+		t.Fatalf("abandoned read = %v, want DNF", read)
+	}
+
+	history, err := books.GetStatusHistory(ctx, userID, pk)
+	if err != nil {
+		t.Fatalf("GetStatusHistory: %v", err)
+	}
+	last := history.Changes[len(history.Changes)-1]
+	if last.ToStatus != book_service.BookStatus_FINISHED || !last.Dnf {
+		t.Fatalf("last change = %v, want DNF back to FINISHED", last)
+	}
+	for _, change := range history.Changes[:len(history.Changes)-1] {
+		if change.Dnf {
+			t.Fatalf("change %v is marked DNF", change)
+		}
+	}
+}
+
+// TestProgressOnFinishedBook needs a new read before progress is recorded on
+// a finished book, so the finished read keeps its last page.
+func TestProgressOnFinishedBook(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books, reads := NewBookRepo(pool), NewReadRepo(pool)
+
+	pk, err := books.Create(ctx, userID, &book_service.Book{
+		Isbn:   "9780306406157",
+		Title:  "Guards! Guards!",
+		Pages:  288,
+		Status: book_service.BookStatus_FINISHED,
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	progress := &book_service.UpdateProgressRequest{
+		BookId:   pk.Id,
+		Progress: &book_service.UpdateProgressRequest_Page{Page: 20},
+	}
+	if _, err := books.UpdateProgress(ctx, userID, progress); !errors.Is(err, storage.ErrInvalidTransition) {
+		t.Fatalf("UpdateProgress on a finished book = %v, want %v", err, storage.ErrInvalidTransition)
+	}
+
+	if _, err := reads.Start(ctx, userID, pk); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if _, err := books.UpdateProgress(ctx, userID, progress); err != nil {
+		t.Fatalf("UpdateProgress on a reread: %v", err)
+	}
+
+	resp, err := reads.GetAll(ctx, userID, &book_service.ReadListRequest{BookId: pk.Id})
+	if err != nil {
+		t.Fatalf("GetAll reads: %v", err)
+	}
+	if len(resp.Reads) != 2 {
+		t.Fatalf("got %d reads, want 2", len(resp.Reads))
+	}
+	for _, read := range resp.Reads {
+		want := int32(288)
+		if read.FinishedAt == "" {
+			want = 20
+		}
+		if read.CurrentPage != want {
+			t.Fatalf("read %d is on page %d, want %d", read.Number, read.CurrentPage, want)
+		}
+	}
+}
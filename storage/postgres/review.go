@@ -0,0 +1,283 @@
+package postgres
+
+import (
+	"book/config"
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/storage"
+
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type ReviewRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewReviewRepo(db *pgxpool.Pool) *ReviewRepo {
+	return &ReviewRepo{
+		db: db,
+	}
+}
+
+// bookRatingExpr is the owner's rating of "book", NULL when unrated. A review
+// of another edition of the same work counts for every edition.
+const bookRatingExpr = `(
+				SELECT r."rating"::FLOAT8 FROM "reviews" r
+				JOIN "book" re ON re."id" = r."book_id"
+				WHERE r."user_id" = "book"."user_id"
+					AND (re."id" = "book"."id" OR re."work_id" = "book"."work_id")
+				ORDER BY re."id" = "book"."id" DESC
+				LIMIT 1
+			)`
+
+const reviewColumns = `
+			r."id",
+			r."book_id",
+			r."rating"::FLOAT8,
+			r."text",
+			r."spoiler",
+			TO_CHAR(r."created_at", ` + config.DatabaseQueryTimeLayout + `),
+			COALESCE(TO_CHAR(r."updated_at", ` + config.DatabaseQueryTimeLayout + `), '')
+`
+
+func scanReview(row rowScanner, dest ...interface{}) (*book_service.Review, error) {
+	var (
+		id        sql.NullInt32
+		bookID    sql.NullInt32
+		rating    sql.NullFloat64
+		text      sql.NullString
+		spoiler   sql.NullBool
+		createdAt sql.NullString
+		updatedAt sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&bookID,
+		&rating,
+		&text,
+		&spoiler,
+		&createdAt,
+		&updatedAt,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &book_service.Review{
+		Id:        id.Int32,
+		BookId:    bookID.Int32,
+		Rating:    float32(rating.Float64),
+		Text:      text.String,
+		Spoiler:   spoiler.Bool,
+		CreatedAt: createdAt.String,
+		UpdatedAt: updatedAt.String,
+	}, nil
+}
+
+// Create reviews req.BookId, or the earliest edition of req.WorkId when set.
+// Only one edition of a work can carry a review, so the book and its work are
+// locked until the review is in and concurrent creates for sibling editions
+// see each other.
+func (r *ReviewRepo) Create(ctx context.Context, userID int32, req *book_service.CreateReviewRequest) (*book_service.Review, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	bookID := req.BookId
+	if req.WorkId > 0 {
+		err := tx.QueryRow(ctx, `
+			SELECT "id" FROM "book" WHERE "work_id" = $1 AND "user_id" = $2 ORDER BY "id" LIMIT 1
+		`, req.WorkId, userID).Scan(&bookID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var workID sql.NullInt32
+	err = tx.QueryRow(ctx, `
+		SELECT "work_id" FROM "book" WHERE "id" = $1 AND "user_id" = $2 FOR UPDATE
+	`, bookID, userID).Scan(&workID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if workID.Valid {
+		_, err = tx.Exec(ctx, `SELECT 1 FROM "works" WHERE "id" = $1 FOR UPDATE`, workID.Int32)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var reviewed bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM "reviews" r
+			JOIN "book" e ON e."id" = r."book_id"
+			JOIN "book" b ON b."work_id" = e."work_id"
+			WHERE b."id" = $1 AND r."user_id" = $2
+		)
+	`, bookID, userID).Scan(&reviewed)
+	if err != nil {
+		return nil, err
+	}
+	if reviewed {
+		return nil, storage.ErrAlreadyExists
+	}
+
+	query := `
+		WITH r AS (
+			INSERT INTO "reviews" (
+				"user_id",
+				"book_id",
+				"rating",
+				"text",
+				"spoiler"
+			)
+			SELECT $1, "id", $3, $4, $5
+			FROM "book"
+			WHERE "id" = $2 AND "user_id" = $1
+			RETURNING *
+		)
+		SELECT` + reviewColumns + `
+		FROM r
+	`
+
+	review, err := scanReview(tx.QueryRow(ctx, query,
+		userID,
+		bookID,
+		req.Rating,
+		req.Text,
+		req.Spoiler,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, storage.ErrAlreadyExists
+	}
+	if isCheckViolation(err) {
+		return nil, storage.ErrOutOfRange
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return review, tx.Commit(ctx)
+}
+
+func (r *ReviewRepo) Update(ctx context.Context, userID int32, req *book_service.UpdateReviewRequest) (*book_service.Review, error) {
+	query := `
+		WITH r AS (
+			UPDATE "reviews"
+			SET
+				"rating" = $3,
+				"text" = $4,
+				"spoiler" = $5,
+				"updated_at" = NOW()
+			WHERE "id" = $1 AND "user_id" = $2
+			RETURNING *
+		)
+		SELECT` + reviewColumns + `
+		FROM r
+	`
+
+	review, err := scanReview(r.db.QueryRow(ctx, query,
+		req.Id,
+		userID,
+		req.Rating,
+		req.Text,
+		req.Spoiler,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+	if isCheckViolation(err) {
+		return nil, storage.ErrOutOfRange
+	}
+
+	return review, err
+}
+
+func (r *ReviewRepo) Delete(ctx context.Context, userID int32, req *book_service.ReviewPK) (int64, error) {
+	query := `DELETE FROM "reviews" WHERE "id" = $1 AND "user_id" = $2`
+
+	result, err := r.db.Exec(ctx, query, req.Id, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (r *ReviewRepo) GetAll(ctx context.Context, userID int32, req *book_service.ReviewListRequest) (resp *book_service.ReviewListResponse, err error) {
+	resp = &book_service.ReviewListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = ` WHERE r."user_id" = :user_id `
+		sort   = ` ORDER BY r."created_at" DESC`
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),
+			COALESCE(AVG(r."rating") OVER(), 0)::FLOAT8,` + reviewColumns + `
+		FROM "reviews" r
+	`
+	params["user_id"] = userID
+	if req.GetBookId() > 0 {
+		filter += ` AND r."book_id" = :book_id `
+		params["book_id"] = req.BookId
+	}
+	if req.GetMinRating() > 0 {
+		filter += ` AND r."rating" >= :min_rating `
+		params["min_rating"] = req.MinRating
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var average float64
+
+		review, err := scanReview(rows, &resp.Count, &average)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.AverageRating = float32(average)
+		resp.Reviews = append(resp.Reviews, review)
+	}
+
+	return resp, rows.Err()
+}
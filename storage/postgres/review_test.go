@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/storage"
+
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestReview rates a book in half stars, once, and filters on the rating.
+func TestReview(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	reviews := NewReviewRepo(pool)
+
+	book, err := NewBookRepo(pool).Create(ctx, userID, &book_service.Book{
+		Isbn:  "9780552131063",
+		Title: "Small Gods",
+		Pages: 384,
+	})
+	if err != nil {
+		t.Fatalf("Create book: %v", err)
+	}
+
+	_, err = reviews.Create(ctx, userID, &book_service.CreateReviewRequest{BookId: book.Id, Rating: 4.2})
+	if !errors.Is(err, storage.ErrOutOfRange) {
+		t.Fatalf("Create with 4.2 stars = %v, want %v", err, storage.ErrOutOfRange)
+	}
+
+	review, err := reviews.Create(ctx, userID, &book_service.CreateReviewRequest{BookId: book.Id, Rating: 4.5, Text: "Om"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	_, err = reviews.Create(ctx, userID, &book_service.CreateReviewRequest{BookId: book.Id, Rating: 3})
+	if !errors.Is(err, storage.ErrAlreadyExists) {
+		t.Fatalf("second Create = %v, want %v", err, storage.ErrAlreadyExists)
+	}
+
+	review, err = reviews.Update(ctx, userID, &book_service.UpdateReviewRequest{Id: review.Id, Rating: 3.5, Text: "Om"})
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if review.Rating != 3.5 || review.UpdatedAt == "" {
+		t.Fatalf("updated review = %v, want 3.5 stars and updated_at set", review)
+	}
+
+	for minRating, want := range map[float32]int{3: 1, 4: 0} {
+		resp, err := reviews.GetAll(ctx, userID, &book_service.ReviewListRequest{MinRating: minRating})
+		if err != nil {
+			t.Fatalf("GetAll: %v", err)
+		}
+		if len(resp.Reviews) != want {
+			t.Fatalf("reviews of at least %v stars = %d, want %d", minRating, len(resp.Reviews), want)
+		}
+	}
+}
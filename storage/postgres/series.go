@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"book/config"
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/storage"
+
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type SeriesRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewSeriesRepo(db *pgxpool.Pool) *SeriesRepo {
+	return &SeriesRepo{
+		db: db,
+	}
+}
+
+// bookSeriesNameColumn selects the name of the series "book" belongs to.
+const bookSeriesNameColumn = `
+			COALESCE((SELECT se."name" FROM "series" se WHERE se."id" = "book"."series_id"), '')`
+
+const seriesColumns = `
+			s."id",
+			s."name",
+			(SELECT COUNT(*) FROM "book" b WHERE b."series_id" = s."id"),
+			(SELECT COUNT(*) FROM "book" b WHERE b."series_id" = s."id" AND b."status" = 2),
+			TO_CHAR(s."created_at", ` + config.DatabaseQueryTimeLayout + `)
+`
+
+func scanSeries(row rowScanner, dest ...interface{}) (*book_service.Series, error) {
+	var (
+		id            sql.NullInt32
+		name          sql.NullString
+		bookCount     sql.NullInt32
+		finishedCount sql.NullInt32
+		createdAt     sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&name,
+		&bookCount,
+		&finishedCount,
+		&createdAt,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	series := &book_service.Series{
+		Id:            id.Int32,
+		Name:          name.String,
+		BookCount:     bookCount.Int32,
+		FinishedCount: finishedCount.Int32,
+		CreatedAt:     createdAt.String,
+	}
+	if series.BookCount > 0 {
+		series.PercentComplete = float32(series.FinishedCount) * 100 / float32(series.BookCount)
+	}
+
+	return series, nil
+}
+
+// setBookSeriesByName puts the book into the user's series called name,
+// creating the series when it does not exist yet.
+func setBookSeriesByName(ctx context.Context, tx pgx.Tx, userID, bookID int32, name string, position float32) error {
+	var seriesID int32
+	err := tx.QueryRow(ctx, `
+		INSERT INTO "series" ("user_id", "name") VALUES ($1, $2)
+		ON CONFLICT ("user_id", "name") DO UPDATE SET "name" = EXCLUDED."name"
+		RETURNING "id"
+	`, userID, name).Scan(&seriesID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE "book" SET "series_id" = $2, "series_position" = $3 WHERE "id" = $1
+	`, bookID, seriesID, position)
+
+	return err
+}
+
+func (s *SeriesRepo) Create(ctx context.Context, userID int32, req *book_service.CreateSeriesRequest) (*book_service.Series, error) {
+	query := `
+		WITH s AS (
+			INSERT INTO "series" ("user_id", "name") VALUES ($1, $2)
+			RETURNING *
+		)
+		SELECT` + seriesColumns + `
+		FROM s
+	`
+
+	series, err := scanSeries(s.db.QueryRow(ctx, query, userID, req.Name))
+	if isUniqueViolation(err) {
+		return nil, storage.ErrAlreadyExists
+	}
+
+	return series, err
+}
+
+func (s *SeriesRepo) GetByPKey(ctx context.Context, userID int32, req *book_service.SeriesPK) (*book_service.Series, error) {
+	query := `
+		SELECT` + seriesColumns + `
+		FROM "series" s
+		WHERE s."id" = $1 AND s."user_id" = $2
+	`
+
+	series, err := scanSeries(s.db.QueryRow(ctx, query, req.Id, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+
+	return series, err
+}
+
+func (s *SeriesRepo) Update(ctx context.Context, userID int32, req *book_service.UpdateSeriesRequest) (*book_service.Series, error) {
+	query := `
+		WITH s AS (
+			UPDATE "series"
+			SET
+				"name" = $3,
+				"updated_at" = NOW()
+			WHERE "id" = $1 AND "user_id" = $2
+			RETURNING *
+		)
+		SELECT` + seriesColumns + `
+		FROM s
+	`
+
+	series, err := scanSeries(s.db.QueryRow(ctx, query, req.Id, userID, req.Name))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, storage.ErrAlreadyExists
+	}
+
+	return series, err
+}
+
+func (s *SeriesRepo) Delete(ctx context.Context, userID int32, req *book_service.SeriesPK) (int64, error) {
+	query := `DELETE FROM "series" WHERE "id" = $1 AND "user_id" = $2`
+
+	result, err := s.db.Exec(ctx, query, req.Id, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (s *SeriesRepo) GetAll(ctx context.Context, userID int32, req *book_service.SeriesListRequest) (resp *book_service.SeriesListResponse, err error) {
+	resp = &book_service.SeriesListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = ` WHERE s."user_id" = :user_id `
+		sort   = ` ORDER BY s."name"`
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),` + seriesColumns + `
+		FROM "series" s
+	`
+	params["user_id"] = userID
+	if len(req.GetSearch()) > 0 {
+		filter += ` AND s."name" ILIKE '%' || :search || '%' `
+		params["search"] = req.Search
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := s.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		series, err := scanSeries(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Series = append(resp.Series, series)
+	}
+
+	return resp, rows.Err()
+}
+
+// SetBook moves the book into the series at position, or out of its series
+// when req.SeriesId is 0.
+func (s *SeriesRepo) SetBook(ctx context.Context, userID int32, req *book_service.SetBookSeriesRequest) error {
+	query := `
+		UPDATE "book"
+		SET
+			"series_id" = NULLIF($3, 0),
+			"series_position" = CASE WHEN $3 = 0 THEN NULL ELSE $4::NUMERIC END,
+			"updated_at" = NOW()
+		WHERE "id" = $1 AND "user_id" = $2
+			AND ($3 = 0 OR EXISTS (SELECT 1 FROM "series" WHERE "id" = $3 AND "user_id" = $2))
+	`
+
+	result, err := s.db.Exec(ctx, query, req.BookId, userID, req.SeriesId, req.Position)
+	if isCheckViolation(err) {
+		return storage.ErrOutOfRange
+	}
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetNext returns the lowest numbered entry of the series that has not been
+// read yet. Wishlist and borrowed entries count, so it may be a book we do
+// not own, but like owned ones only while unread: a position is skipped once
+// any of its entries is finished.
+func (s *SeriesRepo) GetNext(ctx context.Context, userID int32, req *book_service.SeriesPK) (*book_service.Book, error) {
+	query := `
+		SELECT` + bookColumns + `
+		FROM "book"
+		WHERE "series_id" = $1 AND "user_id" = $2 AND "status" <> 2
+			AND NOT EXISTS (
+				SELECT 1 FROM "book" o
+				WHERE o."series_id" = $1 AND o."series_position" = "book"."series_position" AND o."status" = 2
+			)
+		ORDER BY "series_position" NULLS LAST, "book"."id"
+		LIMIT 1
+	`
+
+	book, err := scanBook(s.db.QueryRow(ctx, query, req.Id, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+
+	return book, err
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+
+	"context"
+	"testing"
+)
+
+// TestNextSkipsReadEntries reads the first book of a series from the library
+// and keeps it on the wishlist; the next book is then the second one.
+func TestNextSkipsReadEntries(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books := NewBookRepo(pool)
+
+	entries := []*book_service.Book{
+		{
+			Isbn:           "9780552166591",
+			Title:          "The Colour of Magic",
+			Status:         book_service.BookStatus_FINISHED,
+			Ownership:      book_service.Ownership_BORROWED,
+			BorrowedFrom:   "library",
+			SeriesPosition: 1,
+		},
+		{
+			Isbn:           "9780552124751",
+			Title:          "The Colour of Magic",
+			Ownership:      book_service.Ownership_WISHLIST,
+			SeriesPosition: 1,
+		},
+		{
+			Isbn:           "9780552166607",
+			Title:          "The Light Fantastic",
+			Ownership:      book_service.Ownership_WISHLIST,
+			SeriesPosition: 2,
+		},
+	}
+
+	var last *book_service.BookPK
+	for _, entry := range entries {
+		entry.SeriesName = "Discworld"
+
+		pk, err := books.Create(ctx, userID, entry)
+		if err != nil {
+			t.Fatalf("Create %s: %v", entry.Isbn, err)
+		}
+		last = pk
+	}
+
+	book, err := books.GetByPKey(ctx, userID, last)
+	if err != nil {
+		t.Fatalf("GetByPKey: %v", err)
+	}
+
+	next, err := NewSeriesRepo(pool).GetNext(ctx, userID, &book_service.SeriesPK{Id: book.SeriesId})
+	if err != nil {
+		t.Fatalf("GetNext: %v", err)
+	}
+	if next.Id != last.Id {
+		t.Fatalf("next = %s at %v, want The Light Fantastic at 2", next.Title, next.SeriesPosition)
+	}
+}
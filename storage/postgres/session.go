@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"book/config"
+	"book/genproto/book_service"
+	"book/models"
+	"book/pkg/helper"
+	"book/storage"
+
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type SessionRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewSessionRepo(db *pgxpool.Pool) *SessionRepo {
+	return &SessionRepo{
+		db: db,
+	}
+}
+
+// paceWindowDays is the look-back window used for hours read per day.
+const paceWindowDays = 30
+
+const sessionColumns = `
+			s."id",
+			s."book_id",
+			TO_CHAR(s."started_at", ` + config.DatabaseQueryTimeLayout + `),
+			COALESCE(TO_CHAR(s."ended_at", ` + config.DatabaseQueryTimeLayout + `), ''),
+			s."start_page",
+			COALESCE(s."end_page", 0),
+			COALESCE(s."end_page" - s."start_page", 0),
+			COALESCE(EXTRACT(EPOCH FROM s."ended_at" - s."started_at") / 60, 0)::INTEGER
+`
+
+func scanSession(row rowScanner, dest ...interface{}) (*book_service.ReadingSession, error) {
+	var (
+		id              sql.NullInt32
+		bookID          sql.NullInt32
+		startedAt       sql.NullString
+		endedAt         sql.NullString
+		startPage       sql.NullInt32
+		endPage         sql.NullInt32
+		pagesRead       sql.NullInt32
+		durationMinutes sql.NullInt32
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&bookID,
+		&startedAt,
+		&endedAt,
+		&startPage,
+		&endPage,
+		&pagesRead,
+		&durationMinutes,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &book_service.ReadingSession{
+		Id:              id.Int32,
+		BookId:          bookID.Int32,
+		StartedAt:       startedAt.String,
+		EndedAt:         endedAt.String,
+		StartPage:       startPage.Int32,
+		EndPage:         endPage.Int32,
+		PagesRead:       pagesRead.Int32,
+		DurationMinutes: durationMinutes.Int32,
+	}, nil
+}
+
+func (s *SessionRepo) Start(ctx context.Context, userID int32, req *book_service.StartSessionRequest) (*book_service.ReadingSession, error) {
+	query := `
+		WITH s AS (
+			INSERT INTO "reading_sessions" (
+				"user_id",
+				"book_id",
+				"started_at",
+				"start_page"
+			)
+			SELECT $1, "id", COALESCE($3::TIMESTAMP, NOW()), $4
+			FROM "book"
+			WHERE "id" = $2 AND "user_id" = $1
+			RETURNING *
+		)
+		SELECT` + sessionColumns + `
+		FROM s
+	`
+
+	startedAt, err := helper.NewNullTime(req.StartedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := scanSession(s.db.QueryRow(ctx, query,
+		userID,
+		req.BookId,
+		startedAt,
+		req.StartPage,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, storage.ErrAlreadyExists
+	}
+	if isCheckViolation(err) {
+		return nil, storage.ErrOutOfRange
+	}
+
+	return session, err
+}
+
+func (s *SessionRepo) End(ctx context.Context, userID int32, req *book_service.EndSessionRequest) (*book_service.ReadingSession, error) {
+	query := `
+		WITH s AS (
+			UPDATE "reading_sessions"
+			SET
+				"ended_at" = COALESCE($3::TIMESTAMP, NOW()),
+				"end_page" = $4
+			WHERE "id" = $1 AND "user_id" = $2 AND "ended_at" IS NULL
+			RETURNING *
+		)
+		SELECT` + sessionColumns + `
+		FROM s
+	`
+
+	endedAt, err := helper.NewNullTime(req.EndedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := scanSession(s.db.QueryRow(ctx, query,
+		req.Id,
+		userID,
+		endedAt,
+		req.EndPage,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+	if isCheckViolation(err) {
+		return nil, storage.ErrOutOfRange
+	}
+
+	return session, err
+}
+
+func (s *SessionRepo) GetAll(ctx context.Context, userID int32, req *book_service.SessionListRequest) (resp *book_service.SessionListResponse, err error) {
+	resp = &book_service.SessionListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = ` WHERE s."user_id" = :user_id `
+		sort   = ` ORDER BY s."started_at" DESC`
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),` + sessionColumns + `
+		FROM "reading_sessions" s
+	`
+	params["user_id"] = userID
+	if req.GetBookId() > 0 {
+		filter += ` AND s."book_id" = :book_id `
+		params["book_id"] = req.BookId
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := s.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		session, err := scanSession(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Sessions = append(resp.Sessions, session)
+	}
+
+	return resp, rows.Err()
+}
+
+func (s *SessionRepo) GetPace(ctx context.Context, userID int32) (*models.ReadingPace, error) {
+	query := `
+		SELECT
+			COALESCE(SUM("end_page" - "start_page")::FLOAT8
+				/ NULLIF(SUM(EXTRACT(EPOCH FROM "ended_at" - "started_at")) / 3600, 0), 0),
+			COALESCE(SUM(EXTRACT(EPOCH FROM "ended_at" - "started_at"))
+				FILTER (WHERE "started_at" >= NOW() - $2::INTEGER * INTERVAL '1 day') / 3600 / $2::INTEGER, 0)
+		FROM "reading_sessions"
+		WHERE "user_id" = $1 AND "ended_at" IS NOT NULL AND "ended_at" > "started_at"
+	`
+
+	pace := &models.ReadingPace{}
+
+	err := s.db.QueryRow(ctx, query, userID, paceWindowDays).Scan(&pace.PagesPerHour, &pace.HoursPerDay)
+	if err != nil {
+		return nil, err
+	}
+
+	return pace, nil
+}
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/storage"
+
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestReadingSession reads 30 pages in 45 minutes, starting in a zone other
+// than UTC, and checks a book cannot have two sessions open at once.
+func TestReadingSession(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	sessions := NewSessionRepo(pool)
+
+	book, err := NewBookRepo(pool).Create(ctx, userID, &book_service.Book{
+		Isbn:  "9780552131063",
+		Title: "Small Gods",
+		Pages: 384,
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	start := &book_service.StartSessionRequest{
+		BookId:    book.Id,
+		StartPage: 10,
+		StartedAt: "2024-03-10T10:00:00+02:00",
+	}
+	session, err := sessions.Start(ctx, userID, start)
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if session.StartedAt != "2024-03-10T08:00:00.000Z" {
+		t.Fatalf("started at %s, want 2024-03-10T08:00:00.000Z", session.StartedAt)
+	}
+
+	if _, err := sessions.Start(ctx, userID, start); !errors.Is(err, storage.ErrAlreadyExists) {
+		t.Fatalf("second Start = %v, want %v", err, storage.ErrAlreadyExists)
+	}
+
+	_, err = sessions.End(ctx, userID, &book_service.EndSessionRequest{
+		Id:      session.Id,
+		EndPage: 40,
+		EndedAt: "2024-03-10T07:59:00Z",
+	})
+	if !errors.Is(err, storage.ErrOutOfRange) {
+		t.Fatalf("End before start = %v, want %v", err, storage.ErrOutOfRange)
+	}
+
+	session, err = sessions.End(ctx, userID, &book_service.EndSessionRequest{
+		Id:      session.Id,
+		EndPage: 40,
+		EndedAt: "2024-03-10T08:45:00Z",
+	})
+	if err != nil {
+		t.Fatalf("End: %v", err)
+	}
+	if session.PagesRead != 30 || session.DurationMinutes != 45 {
+		t.Fatalf("session read %d pages in %d minutes, want 30 in 45", session.PagesRead, session.DurationMinutes)
+	}
+
+	pace, err := sessions.GetPace(ctx, userID)
+	if err != nil {
+		t.Fatalf("GetPace: %v", err)
+	}
+	if pace.PagesPerHour != 40 {
+		t.Fatalf("pace = %v pages per hour, want 40", pace.PagesPerHour)
+	}
+}
@@ -0,0 +1,397 @@
+package postgres
+
+import (
+	"book/config"
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/storage"
+
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type ShelfRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewShelfRepo(db *pgxpool.Pool) *ShelfRepo {
+	return &ShelfRepo{
+		db: db,
+	}
+}
+
+// minPositionGap is the smallest gap between neighbours before a shelf's
+// positions are renumbered.
+const minPositionGap = 1e-9
+
+const shelfColumns = `
+			s."id",
+			s."name",
+			s."description",
+			(SELECT COUNT(*) FROM "shelf_books" sb WHERE sb."shelf_id" = s."id"),
+			TO_CHAR(s."created_at", ` + config.DatabaseQueryTimeLayout + `)
+`
+
+func scanShelf(row rowScanner, dest ...interface{}) (*book_service.Shelf, error) {
+	var (
+		id          sql.NullInt32
+		name        sql.NullString
+		description sql.NullString
+		bookCount   sql.NullInt32
+		createdAt   sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&name,
+		&description,
+		&bookCount,
+		&createdAt,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &book_service.Shelf{
+		Id:          id.Int32,
+		Name:        name.String,
+		Description: description.String,
+		BookCount:   bookCount.Int32,
+		CreatedAt:   createdAt.String,
+	}, nil
+}
+
+func (s *ShelfRepo) Create(ctx context.Context, userID int32, req *book_service.CreateShelfRequest) (*book_service.Shelf, error) {
+	query := `
+		WITH s AS (
+			INSERT INTO "shelves" ("user_id", "name", "description") VALUES ($1, $2, $3)
+			RETURNING *
+		)
+		SELECT` + shelfColumns + `
+		FROM s
+	`
+
+	shelf, err := scanShelf(s.db.QueryRow(ctx, query, userID, req.Name, req.Description))
+	if isUniqueViolation(err) {
+		return nil, storage.ErrAlreadyExists
+	}
+
+	return shelf, err
+}
+
+func (s *ShelfRepo) GetByPKey(ctx context.Context, userID int32, req *book_service.ShelfPK) (*book_service.Shelf, error) {
+	query := `
+		SELECT` + shelfColumns + `
+		FROM "shelves" s
+		WHERE s."id" = $1 AND s."user_id" = $2
+	`
+
+	shelf, err := scanShelf(s.db.QueryRow(ctx, query, req.Id, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+
+	return shelf, err
+}
+
+func (s *ShelfRepo) GetAll(ctx context.Context, userID int32, req *book_service.ShelfListRequest) (resp *book_service.ShelfListResponse, err error) {
+	resp = &book_service.ShelfListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = ` WHERE s."user_id" = :user_id `
+		sort   = ` ORDER BY s."created_at" DESC`
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),` + shelfColumns + `
+		FROM "shelves" s
+	`
+	params["user_id"] = userID
+	if len(req.GetSearch()) > 0 {
+		filter += ` AND s."name" ILIKE '%' || :search || '%' `
+		params["search"] = req.Search
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := s.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		shelf, err := scanShelf(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Shelves = append(resp.Shelves, shelf)
+	}
+
+	return resp, rows.Err()
+}
+
+func (s *ShelfRepo) Update(ctx context.Context, userID int32, req *book_service.UpdateShelfRequest) (*book_service.Shelf, error) {
+	query := `
+		WITH s AS (
+			UPDATE "shelves"
+			SET
+				"name" = $3,
+				"description" = $4,
+				"updated_at" = NOW()
+			WHERE "id" = $1 AND "user_id" = $2
+			RETURNING *
+		)
+		SELECT` + shelfColumns + `
+		FROM s
+	`
+
+	shelf, err := scanShelf(s.db.QueryRow(ctx, query, req.Id, userID, req.Name, req.Description))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, storage.ErrAlreadyExists
+	}
+
+	return shelf, err
+}
+
+func (s *ShelfRepo) Delete(ctx context.Context, userID int32, req *book_service.ShelfPK) (int64, error) {
+	query := `DELETE FROM "shelves" WHERE "id" = $1 AND "user_id" = $2`
+
+	result, err := s.db.Exec(ctx, query, req.Id, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// AddBook puts the book at the end of the shelf.
+func (s *ShelfRepo) AddBook(ctx context.Context, userID int32, req *book_service.ShelfBookRequest) error {
+	query := `
+		INSERT INTO "shelf_books" ("shelf_id", "book_id", "position")
+		SELECT s."id", b."id", COALESCE((
+			SELECT MAX("position") FROM "shelf_books" WHERE "shelf_id" = s."id"
+		), 0) + 1
+		FROM "shelves" s, "book" b
+		WHERE s."id" = $1 AND s."user_id" = $3 AND b."id" = $2 AND b."user_id" = $3
+	`
+
+	result, err := s.db.Exec(ctx, query, req.ShelfId, req.BookId, userID)
+	if isUniqueViolation(err) {
+		return storage.ErrAlreadyExists
+	}
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *ShelfRepo) RemoveBook(ctx context.Context, userID int32, req *book_service.ShelfBookRequest) (int64, error) {
+	query := `
+		DELETE FROM "shelf_books" sb
+		USING "shelves" s
+		WHERE s."id" = sb."shelf_id" AND s."user_id" = $3
+			AND sb."shelf_id" = $1 AND sb."book_id" = $2
+	`
+
+	result, err := s.db.Exec(ctx, query, req.ShelfId, req.BookId, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// MoveBook gives the book a position between its new neighbours, so only
+// its own row changes. The shelf is renumbered only once the gap between
+// two neighbours gets too small to split.
+func (s *ShelfRepo) MoveBook(ctx context.Context, userID int32, req *book_service.MoveShelfBookRequest) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var shelfID int32
+	err = tx.QueryRow(ctx, `
+		SELECT s."id" FROM "shelves" s
+		JOIN "shelf_books" sb ON sb."shelf_id" = s."id" AND sb."book_id" = $2
+		WHERE s."id" = $1 AND s."user_id" = $3
+		FOR UPDATE OF s
+	`, req.ShelfId, req.BookId, userID).Scan(&shelfID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return storage.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	position, ok, err := newShelfPosition(ctx, tx, req)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		_, err = tx.Exec(ctx, `
+			UPDATE "shelf_books" sb SET "position" = o."rn"
+			FROM (
+				SELECT "book_id", ROW_NUMBER() OVER (ORDER BY "position", "book_id") AS "rn"
+				FROM "shelf_books" WHERE "shelf_id" = $1
+			) o
+			WHERE sb."shelf_id" = $1 AND sb."book_id" = o."book_id"
+		`, req.ShelfId)
+		if err != nil {
+			return err
+		}
+
+		position, _, err = newShelfPosition(ctx, tx, req)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE "shelf_books" SET "position" = $3 WHERE "shelf_id" = $1 AND "book_id" = $2
+	`, req.ShelfId, req.BookId, position)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// newShelfPosition returns the position that puts the book at index
+// req.Position among the other books of the shelf. ok is false when the
+// neighbours are too close together to fit a position between them.
+func newShelfPosition(ctx context.Context, tx pgx.Tx, req *book_service.MoveShelfBookRequest) (position float64, ok bool, err error) {
+	index := req.Position
+	if index < 0 {
+		index = 0
+	}
+
+	// the neighbour before the target index (if any) and the one after it
+	start := index - 1
+	if start < 0 {
+		start = 0
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT "position" FROM "shelf_books"
+		WHERE "shelf_id" = $1 AND "book_id" <> $2
+		ORDER BY "position", "book_id"
+		OFFSET $3 LIMIT 2
+	`, req.ShelfId, req.BookId, start)
+	if err != nil {
+		return 0, false, err
+	}
+
+	var neighbours []float64
+	for rows.Next() {
+		var p float64
+		if err := rows.Scan(&p); err != nil {
+			rows.Close()
+			return 0, false, err
+		}
+		neighbours = append(neighbours, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, false, err
+	}
+
+	switch {
+	case len(neighbours) == 0:
+		// empty shelf apart from the book, or index past the end
+		var last sql.NullFloat64
+		err = tx.QueryRow(ctx, `
+			SELECT MAX("position") FROM "shelf_books" WHERE "shelf_id" = $1 AND "book_id" <> $2
+		`, req.ShelfId, req.BookId).Scan(&last)
+		return last.Float64 + 1, true, err
+	case index == 0:
+		return neighbours[0] - 1, true, nil
+	case len(neighbours) == 1:
+		return neighbours[0] + 1, true, nil
+	}
+
+	before, after := neighbours[0], neighbours[1]
+	if after-before < minPositionGap {
+		return 0, false, nil
+	}
+
+	return before + (after-before)/2, true, nil
+}
+
+func (s *ShelfRepo) GetBooks(ctx context.Context, userID int32, req *book_service.ShelfBooksRequest) (resp *book_service.ShelfBooksResponse, err error) {
+	resp = &book_service.ShelfBooksResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),` + bookColumns + `
+		FROM "book"
+		JOIN "shelf_books" sb ON sb."book_id" = "book"."id"
+		WHERE sb."shelf_id" = :shelf_id AND "book"."user_id" = :user_id
+		ORDER BY sb."position", "book"."id"
+	`
+	params["shelf_id"] = req.ShelfId
+	params["user_id"] = userID
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := s.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		book, err := scanBook(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Books = append(resp.Books, book)
+	}
+
+	return resp, rows.Err()
+}
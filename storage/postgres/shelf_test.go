@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/storage"
+
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestMoveShelfBook keeps moving two books into the same slot until the gap
+// between the positions runs out and the shelf is renumbered.
+func TestMoveShelfBook(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books, shelves := NewBookRepo(pool), NewShelfRepo(pool)
+
+	shelf, err := shelves.Create(ctx, userID, &book_service.CreateShelfRequest{Name: "Discworld"})
+	if err != nil {
+		t.Fatalf("Create shelf: %v", err)
+	}
+
+	var ids []int32
+	for _, book := range []*book_service.Book{
+		{Isbn: "9780552166591", Title: "The Colour of Magic"},
+		{Isbn: "9780552166607", Title: "The Light Fantastic"},
+		{Isbn: "9780552166614", Title: "Equal Rites"},
+	} {
+		pk, err := books.Create(ctx, userID, book)
+		if err != nil {
+			t.Fatalf("Create %s: %v", book.Title, err)
+		}
+
+		if err := shelves.AddBook(ctx, userID, &book_service.ShelfBookRequest{ShelfId: shelf.Id, BookId: pk.Id}); err != nil {
+			t.Fatalf("AddBook %s: %v", book.Title, err)
+		}
+		ids = append(ids, pk.Id)
+	}
+
+	err = shelves.AddBook(ctx, userID, &book_service.ShelfBookRequest{ShelfId: shelf.Id, BookId: ids[0]})
+	if !errors.Is(err, storage.ErrAlreadyExists) {
+		t.Fatalf("AddBook twice = %v, want %v", err, storage.ErrAlreadyExists)
+	}
+
+	for i := 0; i < 40; i++ {
+		moved := ids[1+i%2]
+
+		err := shelves.MoveBook(ctx, userID, &book_service.MoveShelfBookRequest{ShelfId: shelf.Id, BookId: moved, Position: 1})
+		if err != nil {
+			t.Fatalf("MoveBook %d: %v", i, err)
+		}
+
+		resp, err := shelves.GetBooks(ctx, userID, &book_service.ShelfBooksRequest{ShelfId: shelf.Id})
+		if err != nil {
+			t.Fatalf("GetBooks: %v", err)
+		}
+		if len(resp.Books) != 3 || resp.Books[0].Id != ids[0] || resp.Books[1].Id != moved {
+			t.Fatalf("after move %d the shelf is %v, want %d second", i, resp.Books, moved)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type StatsRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewStatsRepo(db *pgxpool.Pool) *StatsRepo {
+	return &StatsRepo{
+		db: db,
+	}
+}
+
+// finishedInRange selects the user's ($1) read-throughs finished between the
+// dates $2 and $3 as "finished", one row per read, so a book read twice is
+// there twice. Both bounds are inclusive and an empty one is open.
+const finishedInRange = `
+		WITH "finished" AS (
+			SELECT
+				"book"."id",
+				"book"."pages",
+				r."finished_at",
+				r."started_at"
+			FROM "reads" r
+			JOIN "book" ON "book"."id" = r."book_id"
+			WHERE "book"."user_id" = $1 AND` + readCountedCond + `
+				AND r."finished_at" >= COALESCE(NULLIF($2, '')::DATE, '-infinity')
+				AND r."finished_at" < COALESCE(NULLIF($3, '')::DATE + 1, 'infinity')
+		)
+`
+
+// Get runs its queries in one read-only repeatable read transaction, so the
+// totals, periods and statuses all describe the same snapshot.
+func (s *StatsRepo) Get(ctx context.Context, userID int32, req *book_service.StatsRequest) (*book_service.Stats, error) {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	resp := &book_service.Stats{}
+
+	query := finishedInRange + `
+		SELECT
+			COUNT(*),
+			COALESCE(SUM("pages"), 0),
+			COALESCE(AVG("pages"), 0)::FLOAT8,
+			COALESCE(AVG(GREATEST(EXTRACT(EPOCH FROM "finished_at" - "started_at"), 0) / 86400), 0)::FLOAT8
+		FROM "finished"
+	`
+
+	var averagePages, averageDays float64
+	err = tx.QueryRow(ctx, query, userID, req.From, req.To).Scan(
+		&resp.BooksFinished,
+		&resp.PagesRead,
+		&averagePages,
+		&averageDays,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp.AveragePages = float32(averagePages)
+	resp.AverageDaysToFinish = float32(averageDays)
+
+	resp.PerMonth, err = getPerPeriod(ctx, tx, userID, req, "YYYY-MM")
+	if err != nil {
+		return nil, err
+	}
+
+	resp.PerYear, err = getPerPeriod(ctx, tx, userID, req, "YYYY")
+	if err != nil {
+		return nil, err
+	}
+
+	resp.TopAuthors, err = getTopAuthors(ctx, tx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Statuses, err = getStatuses(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	query = `
+		SELECT
+			COUNT(*) FILTER (WHERE "ownership" = 0),
+			COUNT(*) FILTER (WHERE "ownership" = 1),
+			COUNT(*) FILTER (WHERE "ownership" = 2)
+		FROM "book"
+		WHERE "user_id" = $1
+	`
+
+	err = tx.QueryRow(ctx, query, userID).Scan(&resp.OwnedBooks, &resp.BorrowedBooks, &resp.WishlistBooks)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, tx.Commit(ctx)
+}
+
+func getPerPeriod(ctx context.Context, tx pgx.Tx, userID int32, req *book_service.StatsRequest, format string) ([]*book_service.PeriodStat, error) {
+	query := finishedInRange + `
+		SELECT TO_CHAR("finished_at", $4), COUNT(*), COALESCE(SUM("pages"), 0)
+		FROM "finished"
+		GROUP BY 1
+		ORDER BY 1
+	`
+
+	rows, err := tx.Query(ctx, query, userID, req.From, req.To, format)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var periods []*book_service.PeriodStat
+	for rows.Next() {
+		period := &book_service.PeriodStat{}
+		if err := rows.Scan(&period.Period, &period.Books, &period.Pages); err != nil {
+			return nil, err
+		}
+
+		periods = append(periods, period)
+	}
+
+	return periods, rows.Err()
+}
+
+func getTopAuthors(ctx context.Context, tx pgx.Tx, userID int32, req *book_service.StatsRequest) ([]*book_service.AuthorStat, error) {
+	query := finishedInRange + `
+		SELECT a."id", a."name", COUNT(*)
+		FROM "finished" f
+		JOIN "book_authors" ba ON ba."book_id" = f."id" AND ba."role" = 'author'
+		JOIN "authors" a ON a."id" = ba."author_id"
+		GROUP BY a."id", a."name"
+		ORDER BY 3 DESC, a."name"
+		LIMIT $4
+	`
+
+	rows, err := tx.Query(ctx, query, userID, req.From, req.To, req.TopAuthors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var authors []*book_service.AuthorStat
+	for rows.Next() {
+		author := &book_service.AuthorStat{}
+		if err := rows.Scan(&author.AuthorId, &author.Name, &author.Books); err != nil {
+			return nil, err
+		}
+
+		authors = append(authors, author)
+	}
+
+	return authors, rows.Err()
+}
+
+func getStatuses(ctx context.Context, tx pgx.Tx, userID int32) ([]*book_service.StatusStat, error) {
+	query := `
+		SELECT "status", COUNT(*)
+		FROM "book"
+		WHERE "user_id" = $1 AND "ownership" <> 2
+		GROUP BY "status"
+		ORDER BY "status"
+	`
+
+	rows, err := tx.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var statuses []*book_service.StatusStat
+	for rows.Next() {
+		var (
+			bookStatus int32
+			stat       = &book_service.StatusStat{}
+		)
+		if err := rows.Scan(&bookStatus, &stat.Books); err != nil {
+			return nil, err
+		}
+		stat.Status = book_service.BookStatus(bookStatus)
+
+		statuses = append(statuses, stat)
+	}
+
+	return statuses, rows.Err()
+}
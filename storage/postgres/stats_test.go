@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+
+	"context"
+	"testing"
+)
+
+// TestStatsRange counts the reads finished in 2023: a reread in 2024 and a
+// read given up are left out.
+func TestStatsRange(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books := NewBookRepo(pool)
+
+	ids := map[string]int32{}
+	for _, book := range []*book_service.Book{
+		{Isbn: "9780552131063", Title: "Small Gods", Pages: 300, Authors: []*book_service.BookAuthor{{Name: "Terry Pratchett"}}},
+		{Isbn: "9780060853983", Title: "Good Omens", Pages: 400, Authors: []*book_service.BookAuthor{{Name: "Terry Pratchett"}, {Name: "Neil Gaiman"}}},
+	} {
+		pk, err := books.Create(ctx, userID, book)
+		if err != nil {
+			t.Fatalf("Create %s: %v", book.Title, err)
+		}
+		ids[book.Title] = pk.Id
+	}
+
+	for _, read := range []struct {
+		title             string
+		started, finished string
+		dnf               bool
+	}{
+		{"Small Gods", "2023-01-05", "2023-01-15", false},
+		{"Good Omens", "2023-02-01", "2023-02-10", false},
+		{"Good Omens", "2023-02-15", "2023-02-20", true},
+		{"Small Gods", "2024-02-20", "2024-03-01", false},
+	} {
+		_, err := pool.Exec(ctx, `
+			INSERT INTO "reads" ("book_id", "started_at", "finished_at", "dnf") VALUES ($1, $2::DATE, $3::DATE, $4)
+		`, ids[read.title], read.started, read.finished, read.dnf)
+		if err != nil {
+			t.Fatalf("insert read of %s: %v", read.title, err)
+		}
+	}
+
+	stats, err := NewStatsRepo(pool).Get(ctx, userID, &book_service.StatsRequest{From: "2023-01-01", To: "2023-12-31", TopAuthors: 1})
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	if stats.BooksFinished != 2 || stats.PagesRead != 700 || stats.AverageDaysToFinish != 9.5 {
+		t.Fatalf("2023 = %d books, %d pages, %v days each, want 2 books, 700 pages, 9.5 days each",
+			stats.BooksFinished, stats.PagesRead, stats.AverageDaysToFinish)
+	}
+	if len(stats.PerMonth) != 2 || stats.PerMonth[0].Period != "2023-01" || stats.PerMonth[1].Pages != 400 {
+		t.Fatalf("per month = %v, want 2023-01 and 2023-02 with 400 pages", stats.PerMonth)
+	}
+	if len(stats.TopAuthors) != 1 || stats.TopAuthors[0].Name != "Terry Pratchett" || stats.TopAuthors[0].Books != 2 {
+		t.Fatalf("top authors = %v, want Terry Pratchett with 2 books", stats.TopAuthors)
+	}
+}
@@ -0,0 +1,325 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/storage"
+
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type TagRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewTagRepo(db *pgxpool.Pool) *TagRepo {
+	return &TagRepo{
+		db: db,
+	}
+}
+
+// bookTagsColumn selects the tags of "book" as JSON.
+const bookTagsColumn = `
+			COALESCE((
+				SELECT json_agg(json_build_object('id', t."id", 'name', t."name") ORDER BY t."name")
+				FROM "book_tags" bt
+				JOIN "tags" t ON t."id" = bt."tag_id"
+				WHERE bt."book_id" = "book"."id"
+			), '[]')`
+
+const tagColumns = `
+			t."id",
+			t."name",
+			(SELECT COUNT(*) FROM "book_tags" bt WHERE bt."tag_id" = t."id")
+`
+
+type bookTagJSON struct {
+	Id   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+func decodeBookTags(data []byte) ([]*book_service.Tag, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var rows []bookTagJSON
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, err
+	}
+
+	tags := make([]*book_service.Tag, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, &book_service.Tag{
+			Id:   row.Id,
+			Name: row.Name,
+		})
+	}
+
+	return tags, nil
+}
+
+func scanTag(row rowScanner, dest ...interface{}) (*book_service.Tag, error) {
+	var (
+		id        sql.NullInt32
+		name      sql.NullString
+		bookCount sql.NullInt32
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&name,
+		&bookCount,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &book_service.Tag{
+		Id:        id.Int32,
+		Name:      name.String,
+		BookCount: bookCount.Int32,
+	}, nil
+}
+
+func (t *TagRepo) Create(ctx context.Context, userID int32, req *book_service.CreateTagRequest) (*book_service.Tag, error) {
+	query := `
+		WITH t AS (
+			INSERT INTO "tags" ("user_id", "name") VALUES ($1, $2)
+			RETURNING *
+		)
+		SELECT` + tagColumns + `
+		FROM t
+	`
+
+	tag, err := scanTag(t.db.QueryRow(ctx, query, userID, req.Name))
+	if isUniqueViolation(err) {
+		return nil, storage.ErrAlreadyExists
+	}
+
+	return tag, err
+}
+
+func (t *TagRepo) GetByPKey(ctx context.Context, userID int32, req *book_service.TagPK) (*book_service.Tag, error) {
+	query := `
+		SELECT` + tagColumns + `
+		FROM "tags" t
+		WHERE t."id" = $1 AND t."user_id" = $2
+	`
+
+	tag, err := scanTag(t.db.QueryRow(ctx, query, req.Id, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+
+	return tag, err
+}
+
+func (t *TagRepo) Rename(ctx context.Context, userID int32, req *book_service.RenameTagRequest) (*book_service.Tag, error) {
+	query := `
+		WITH t AS (
+			UPDATE "tags" SET "name" = $3
+			WHERE "id" = $1 AND "user_id" = $2
+			RETURNING *
+		)
+		SELECT` + tagColumns + `
+		FROM t
+	`
+
+	tag, err := scanTag(t.db.QueryRow(ctx, query, req.Id, userID, req.Name))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, storage.ErrAlreadyExists
+	}
+
+	return tag, err
+}
+
+// Merge moves the books of the source tags onto the target tag and deletes
+// the source tags.
+func (t *TagRepo) Merge(ctx context.Context, userID int32, req *book_service.MergeTagsRequest) error {
+	ids := append([]int32{req.TargetId}, helper.Difference(helper.Unique(req.SourceIds), []int32{req.TargetId})...)
+
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := checkTagsOwned(ctx, tx, userID, ids); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO "book_tags" ("tag_id", "book_id")
+		SELECT $1, "book_id" FROM "book_tags" WHERE "tag_id" = ANY($2)
+		ON CONFLICT DO NOTHING
+	`, req.TargetId, ids[1:])
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM "tags" WHERE "id" = ANY($1)`, ids[1:])
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (t *TagRepo) Delete(ctx context.Context, userID int32, req *book_service.TagPK) (int64, error) {
+	query := `DELETE FROM "tags" WHERE "id" = $1 AND "user_id" = $2`
+
+	result, err := t.db.Exec(ctx, query, req.Id, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (t *TagRepo) GetAll(ctx context.Context, userID int32, req *book_service.TagListRequest) (resp *book_service.TagListResponse, err error) {
+	resp = &book_service.TagListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = ` WHERE t."user_id" = :user_id `
+		sort   = ` ORDER BY t."name"`
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),` + tagColumns + `
+		FROM "tags" t
+	`
+	params["user_id"] = userID
+	if len(req.GetSearch()) > 0 {
+		filter += ` AND t."name" ILIKE '%' || :search || '%' `
+		params["search"] = req.Search
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := t.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		tag, err := scanTag(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Tags = append(resp.Tags, tag)
+	}
+
+	return resp, rows.Err()
+}
+
+// SetBookTags makes tag_ids the exact tag list of the book, touching only
+// the links that changed.
+func (t *TagRepo) SetBookTags(ctx context.Context, userID int32, req *book_service.SetBookTagsRequest) error {
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var bookID int32
+	err = tx.QueryRow(ctx, `
+		SELECT "id" FROM "book" WHERE "id" = $1 AND "user_id" = $2 FOR UPDATE
+	`, req.BookId, userID).Scan(&bookID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return storage.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	wanted := helper.Unique(req.TagIds)
+	if err := checkTagsOwned(ctx, tx, userID, wanted); err != nil {
+		return err
+	}
+
+	var current []int32
+	rows, err := tx.Query(ctx, `SELECT "tag_id" FROM "book_tags" WHERE "book_id" = $1`, bookID)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var tagID int32
+		if err := rows.Scan(&tagID); err != nil {
+			rows.Close()
+			return err
+		}
+		current = append(current, tagID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if removed := helper.Difference(current, wanted); len(removed) > 0 {
+		query, params := helper.ValMultipleQuery(`DELETE FROM "book_tags" WHERE "tag_id" IN (`, removed)
+		query += ` AND "book_id" = $` + strconv.Itoa(len(params)+1)
+
+		if _, err := tx.Exec(ctx, query, append(params, bookID)...); err != nil {
+			return err
+		}
+	}
+
+	if added := helper.Difference(wanted, current); len(added) > 0 {
+		query, params := helper.InsertMultiple(`INSERT INTO "book_tags" ("tag_id", "book_id") VALUES `, bookID, added)
+		query += ` ON CONFLICT DO NOTHING`
+
+		if _, err := tx.Exec(ctx, query, params...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// checkTagsOwned returns storage.ErrNotFound unless every id is a tag of the
+// user.
+func checkTagsOwned(ctx context.Context, tx pgx.Tx, userID int32, ids []int32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var owned int
+	err := tx.QueryRow(ctx, `
+		SELECT COUNT(*) FROM "tags" WHERE "user_id" = $1 AND "id" = ANY($2)
+	`, userID, ids).Scan(&owned)
+	if err != nil {
+		return err
+	}
+
+	if owned != len(ids) {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/storage"
+
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestMergeTags tags two books and merges one tag into the other, which keeps
+// both books without tagging either twice.
+func TestMergeTags(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books, tags := NewBookRepo(pool), NewTagRepo(pool)
+
+	fantasy, err := tags.Create(ctx, userID, &book_service.CreateTagRequest{Name: "fantasy"})
+	if err != nil {
+		t.Fatalf("Create fantasy: %v", err)
+	}
+	satire, err := tags.Create(ctx, userID, &book_service.CreateTagRequest{Name: "satire"})
+	if err != nil {
+		t.Fatalf("Create satire: %v", err)
+	}
+	if _, err := tags.Create(ctx, userID, &book_service.CreateTagRequest{Name: "fantasy"}); !errors.Is(err, storage.ErrAlreadyExists) {
+		t.Fatalf("second Create fantasy = %v, want %v", err, storage.ErrAlreadyExists)
+	}
+
+	for _, entry := range []struct {
+		book   *book_service.Book
+		tagIDs []int32
+	}{
+		{&book_service.Book{Isbn: "9780552131063", Title: "Small Gods"}, []int32{fantasy.Id}},
+		{&book_service.Book{Isbn: "9780552134644", Title: "Pyramids"}, []int32{satire.Id, fantasy.Id, satire.Id}},
+	} {
+		book, err := books.Create(ctx, userID, entry.book)
+		if err != nil {
+			t.Fatalf("Create %s: %v", entry.book.Title, err)
+		}
+
+		err = tags.SetBookTags(ctx, userID, &book_service.SetBookTagsRequest{BookId: book.Id, TagIds: entry.tagIDs})
+		if err != nil {
+			t.Fatalf("SetBookTags %s: %v", entry.book.Title, err)
+		}
+	}
+
+	if err := tags.Merge(ctx, userID, &book_service.MergeTagsRequest{TargetId: fantasy.Id, SourceIds: []int32{satire.Id}}); err != nil {
+		t.Fatalf("Merge: %v", err)
+	}
+
+	if _, err := tags.GetByPKey(ctx, userID, &book_service.TagPK{Id: satire.Id}); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("GetByPKey merged tag = %v, want %v", err, storage.ErrNotFound)
+	}
+
+	fantasy, err = tags.GetByPKey(ctx, userID, &book_service.TagPK{Id: fantasy.Id})
+	if err != nil {
+		t.Fatalf("GetByPKey: %v", err)
+	}
+	if fantasy.BookCount != 2 {
+		t.Fatalf("fantasy has %d books, want 2", fantasy.BookCount)
+	}
+}
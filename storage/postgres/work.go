@@ -0,0 +1,237 @@
+package postgres
+
+import (
+	"book/config"
+	"book/genproto/book_service"
+	"book/pkg/helper"
+	"book/storage"
+
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type WorkRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewWorkRepo(db *pgxpool.Pool) *WorkRepo {
+	return &WorkRepo{
+		db: db,
+	}
+}
+
+// bookWorkColumns selects the work of "book" and how many editions of it are
+// on the shelf.
+const bookWorkColumns = `
+			COALESCE("book"."work_id", 0),
+			COALESCE((SELECT w."key" FROM "works" w WHERE w."id" = "book"."work_id"), ''),
+			CASE WHEN "book"."work_id" IS NULL THEN 1 ELSE (
+				SELECT COUNT(*) FROM "book" e WHERE e."work_id" = "book"."work_id"
+			) END`
+
+// bookEarliestEditionCond holds for books outside a work and for the first
+// edition of each work added to the shelf.
+const bookEarliestEditionCond = `
+			NOT EXISTS (
+				SELECT 1 FROM "book" e
+				WHERE e."work_id" = "book"."work_id" AND e."id" < "book"."id"
+			)`
+
+const workColumns = `
+			w."id",
+			w."provider",
+			w."key",
+			w."title",
+			COALESCE((
+				SELECT e."status" FROM "book" e
+				WHERE e."work_id" = w."id"
+				ORDER BY e."updated_at" DESC NULLS LAST, e."id" DESC
+				LIMIT 1
+			), 0),
+			(SELECT COUNT(*) FROM "book" e WHERE e."work_id" = w."id"),
+			TO_CHAR(w."created_at", ` + config.DatabaseQueryTimeLayout + `)
+`
+
+func scanWork(row rowScanner, dest ...interface{}) (*book_service.Work, error) {
+	var (
+		id           sql.NullInt32
+		provider     sql.NullString
+		key          sql.NullString
+		title        sql.NullString
+		status       sql.NullInt32
+		editionCount sql.NullInt32
+		createdAt    sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&provider,
+		&key,
+		&title,
+		&status,
+		&editionCount,
+		&createdAt,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &book_service.Work{
+		Id:           id.Int32,
+		Provider:     provider.String,
+		Key:          key.String,
+		Title:        title.String,
+		Status:       book_service.BookStatus(status.Int32),
+		EditionCount: editionCount.Int32,
+		CreatedAt:    createdAt.String,
+	}, nil
+}
+
+// linkWork finds or creates the user's work for the provider key. When other
+// editions of the work are already on the shelf, inherited is the status of
+// the most recently updated one, so a new edition continues its history.
+func linkWork(ctx context.Context, tx pgx.Tx, userID int32, provider, key, title string) (workID int32, inherited *book_service.BookStatus, err error) {
+	err = tx.QueryRow(ctx, `
+		INSERT INTO "works" ("user_id", "provider", "key", "title") VALUES ($1, $2, $3, $4)
+		ON CONFLICT ("user_id", "provider", "key") DO UPDATE SET "key" = EXCLUDED."key"
+		RETURNING "id"
+	`, userID, provider, key, title).Scan(&workID)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var status int32
+	err = tx.QueryRow(ctx, `
+		SELECT "status" FROM "book"
+		WHERE "work_id" = $1
+		ORDER BY "updated_at" DESC NULLS LAST, "id" DESC
+		LIMIT 1
+	`, workID).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return workID, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	inherited = new(book_service.BookStatus)
+	*inherited = book_service.BookStatus(status)
+
+	return workID, inherited, nil
+}
+
+func (w *WorkRepo) GetByPKey(ctx context.Context, userID int32, req *book_service.WorkPK) (*book_service.Work, error) {
+	query := `
+		SELECT` + workColumns + `
+		FROM "works" w
+		WHERE w."id" = $1 AND w."user_id" = $2
+	`
+
+	work, err := scanWork(w.db.QueryRow(ctx, query, req.Id, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, storage.ErrNotFound
+	}
+
+	return work, err
+}
+
+func (w *WorkRepo) GetEditions(ctx context.Context, userID int32, req *book_service.EditionListRequest) (resp *book_service.BookListResponse, err error) {
+	resp = &book_service.BookListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),
+			COUNT(*) FILTER (WHERE "ownership" = 0) OVER(),` + bookColumns + `
+		FROM "book"
+		WHERE "work_id" = :work_id AND "user_id" = :user_id
+		ORDER BY "book"."id"
+	`
+	params["work_id"] = req.WorkId
+	params["user_id"] = userID
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+	rows, err := w.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		book, err := scanBook(rows, &resp.Count, &resp.OwnedCount)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Books = append(resp.Books, book)
+	}
+
+	return resp, rows.Err()
+}
+
+// SetStatus moves every edition of the work to req.Status, recording each
+// change in the editions' status history. Nothing changes if any edition
+// cannot make the transition.
+func (w *WorkRepo) SetStatus(ctx context.Context, userID int32, req *book_service.SetWorkStatusRequest) error {
+	tx, err := w.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		SELECT b."id" FROM "book" b
+		JOIN "works" w ON w."id" = b."work_id"
+		WHERE w."id" = $1 AND w."user_id" = $2
+		ORDER BY b."id"
+	`, req.WorkId, userID)
+	if err != nil {
+		return err
+	}
+
+	var editions []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		editions = append(editions, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(editions) == 0 {
+		return storage.ErrNotFound
+	}
+
+	for _, id := range editions {
+		if _, err := changeStatus(ctx, tx, userID, id, req.Status); err != nil {
+			return fmt.Errorf("edition %d: %w", id, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"book/genproto/book_service"
+	"book/models"
+	"book/storage"
+
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestWorkStatus moves both editions of a work together, refusing a move one
+// of them cannot make, and counts the work once when it is finished.
+func TestWorkStatus(t *testing.T) {
+	pool, userID := newTestPool(t)
+	ctx := context.Background()
+	books, works := NewBookRepo(pool), NewWorkRepo(pool)
+
+	var editions []*book_service.BookPK
+	for _, isbn := range []string{"9780552131063", "9780552166676"} {
+		pk, err := books.Create(ctx, userID, &book_service.Book{
+			Isbn:    isbn,
+			Title:   "Small Gods",
+			Pages:   384,
+			WorkKey: "OL453940W",
+		})
+		if err != nil {
+			t.Fatalf("Create %s: %v", isbn, err)
+		}
+		editions = append(editions, pk)
+	}
+
+	book, err := books.GetByPKey(ctx, userID, editions[0])
+	if err != nil {
+		t.Fatalf("GetByPKey: %v", err)
+	}
+	workID := book.WorkId
+
+	_, err = books.UpdatePatch(ctx, userID, &models.UpdatePatchRequest{
+		Id:       editions[1].Id,
+		Updpatch: book_service.BookData{Status: book_service.BookStatus_READING},
+	})
+	if err != nil {
+		t.Fatalf("UpdatePatch: %v", err)
+	}
+
+	setStatus := func(status book_service.BookStatus) error {
+		return works.SetStatus(ctx, userID, &book_service.SetWorkStatusRequest{WorkId: workID, Status: status})
+	}
+
+	if err := setStatus(book_service.BookStatus_FINISHED); !errors.Is(err, storage.ErrInvalidTransition) {
+		t.Fatalf("finish a work with an unread edition = %v, want %v", err, storage.ErrInvalidTransition)
+	}
+	book, err = books.GetByPKey(ctx, userID, editions[1])
+	if err != nil {
+		t.Fatalf("GetByPKey: %v", err)
+	}
+	if book.Status != book_service.BookStatus_READING {
+		t.Fatalf("edition after a refused move = %s, want READING", book.Status)
+	}
+
+	for _, status := range []book_service.BookStatus{book_service.BookStatus_READING, book_service.BookStatus_FINISHED} {
+		if err := setStatus(status); err != nil {
+			t.Fatalf("move work to %s: %v", status, err)
+		}
+	}
+
+	resp, err := works.GetEditions(ctx, userID, &book_service.EditionListRequest{WorkId: workID})
+	if err != nil {
+		t.Fatalf("GetEditions: %v", err)
+	}
+	if len(resp.Books) != 2 {
+		t.Fatalf("work has %d editions, want 2", len(resp.Books))
+	}
+	for _, edition := range resp.Books {
+		if edition.Status != book_service.BookStatus_FINISHED {
+			t.Fatalf("edition %d = %s, want FINISHED", edition.Id, edition.Status)
+		}
+	}
+
+	collapsed, err := books.GetAll(ctx, userID, &book_service.BookListRequest{CollapseByWork: true})
+	if err != nil {
+		t.Fatalf("GetAll: %v", err)
+	}
+	if len(collapsed.Books) != 1 || collapsed.Books[0].Id != editions[0].Id {
+		t.Fatalf("books by work = %v, want the first edition only", collapsed.Books)
+	}
+
+	stats, err := NewStatsRepo(pool).Get(ctx, userID, &book_service.StatsRequest{})
+	if err != nil {
+		t.Fatalf("Get stats: %v", err)
+	}
+	if stats.BooksFinished != 1 {
+		t.Fatalf("finished %d books, want the work once", stats.BooksFinished)
+	}
+}
@@ -5,19 +5,161 @@ import (
 	"book/models"
 
 	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrAlreadyExists = errors.New("already exists")
+	ErrNotFound      = errors.New("not found")
+
+	ErrInvalidTransition = errors.New("invalid status transition")
+	ErrOutOfRange        = errors.New("out of range")
+	ErrLent              = errors.New("book is lent out")
+	ErrNotOwned          = errors.New("book is not owned")
 )
 
 type StorageI interface {
 	CloseDB()
 	Book() BookRepoI
+	MetadataCache() MetadataCacheRepoI
+	Author() AuthorRepoI
+	Session() SessionRepoI
+	Review() ReviewRepoI
+	Tag() TagRepoI
+	Shelf() ShelfRepoI
+	Goal() GoalRepoI
+	Stats() StatsRepoI
+	Note() NoteRepoI
+	Loan() LoanRepoI
+	Series() SeriesRepoI
+	Work() WorkRepoI
+	Location() LocationRepoI
+	Copy() CopyRepoI
+	Read() ReadRepoI
 }
 
 type BookRepoI interface {
-	Create(ctx context.Context,  req *book_service.CreateBook) (*book_service.BookPK, error)
-	GetByPKey(context.Context, *book_service.BookPK) (*book_service.Book, error)
-	GetAll(context.Context, *book_service.BookListRequest) (*book_service.BookListResponse, error)
-	Update(context.Context, *book_service.UpdateBook) (int64, error)
-	UpdatePatch(context.Context, *models.UpdatePatchRequest) (int64, error)
-	Delete(context.Context, *book_service.BookPK) error
-	GetBookByTitle(context.Context, *book_service.BookByTitle) (*book_service.Book, error)
+	Create(ctx context.Context, userID int32, req *book_service.Book) (*book_service.BookPK, error)
+	GetByPKey(ctx context.Context, userID int32, req *book_service.BookPK) (*book_service.Book, error)
+	GetAll(ctx context.Context, userID int32, req *book_service.BookListRequest) (*book_service.BookListResponse, error)
+	Update(ctx context.Context, userID int32, req *book_service.UpdateBook) (int64, error)
+	UpdatePatch(ctx context.Context, userID int32, req *models.UpdatePatchRequest) (int64, error)
+	Delete(ctx context.Context, userID int32, req *book_service.DeleteBookRequest) error
+	GetBookByTitle(ctx context.Context, userID int32, req *book_service.BookByTitle) (*book_service.Book, error)
+	GetStatusHistory(ctx context.Context, userID int32, req *book_service.BookPK) (*book_service.StatusHistoryResponse, error)
+	UpdateProgress(ctx context.Context, userID int32, req *book_service.UpdateProgressRequest) (int64, error)
+	SetOwnership(ctx context.Context, userID int32, req *book_service.SetOwnershipRequest) (int64, error)
+	GetDueSoon(ctx context.Context, userID int32, req *book_service.DueSoonRequest) (*book_service.BookListResponse, error)
+}
+
+type MetadataCacheRepoI interface {
+	Get(ctx context.Context, isbn string) (*models.MetadataCache, error)
+	Upsert(ctx context.Context, req *models.MetadataCache) error
+	GetStale(ctx context.Context, limit int32) ([]string, error)
+	MarkFailed(ctx context.Context, isbn string, base, max time.Duration) error
+}
+
+type AuthorRepoI interface {
+	GetAll(ctx context.Context, userID int32, req *book_service.AuthorListRequest) (*book_service.AuthorListResponse, error)
+}
+
+type SessionRepoI interface {
+	Start(ctx context.Context, userID int32, req *book_service.StartSessionRequest) (*book_service.ReadingSession, error)
+	End(ctx context.Context, userID int32, req *book_service.EndSessionRequest) (*book_service.ReadingSession, error)
+	GetAll(ctx context.Context, userID int32, req *book_service.SessionListRequest) (*book_service.SessionListResponse, error)
+	GetPace(ctx context.Context, userID int32) (*models.ReadingPace, error)
+}
+
+type ReviewRepoI interface {
+	Create(ctx context.Context, userID int32, req *book_service.CreateReviewRequest) (*book_service.Review, error)
+	Update(ctx context.Context, userID int32, req *book_service.UpdateReviewRequest) (*book_service.Review, error)
+	Delete(ctx context.Context, userID int32, req *book_service.ReviewPK) (int64, error)
+	GetAll(ctx context.Context, userID int32, req *book_service.ReviewListRequest) (*book_service.ReviewListResponse, error)
+}
+
+type TagRepoI interface {
+	Create(ctx context.Context, userID int32, req *book_service.CreateTagRequest) (*book_service.Tag, error)
+	GetByPKey(ctx context.Context, userID int32, req *book_service.TagPK) (*book_service.Tag, error)
+	Rename(ctx context.Context, userID int32, req *book_service.RenameTagRequest) (*book_service.Tag, error)
+	Merge(ctx context.Context, userID int32, req *book_service.MergeTagsRequest) error
+	Delete(ctx context.Context, userID int32, req *book_service.TagPK) (int64, error)
+	GetAll(ctx context.Context, userID int32, req *book_service.TagListRequest) (*book_service.TagListResponse, error)
+	SetBookTags(ctx context.Context, userID int32, req *book_service.SetBookTagsRequest) error
+}
+
+type ShelfRepoI interface {
+	Create(ctx context.Context, userID int32, req *book_service.CreateShelfRequest) (*book_service.Shelf, error)
+	GetByPKey(ctx context.Context, userID int32, req *book_service.ShelfPK) (*book_service.Shelf, error)
+	GetAll(ctx context.Context, userID int32, req *book_service.ShelfListRequest) (*book_service.ShelfListResponse, error)
+	Update(ctx context.Context, userID int32, req *book_service.UpdateShelfRequest) (*book_service.Shelf, error)
+	Delete(ctx context.Context, userID int32, req *book_service.ShelfPK) (int64, error)
+	AddBook(ctx context.Context, userID int32, req *book_service.ShelfBookRequest) error
+	RemoveBook(ctx context.Context, userID int32, req *book_service.ShelfBookRequest) (int64, error)
+	MoveBook(ctx context.Context, userID int32, req *book_service.MoveShelfBookRequest) error
+	GetBooks(ctx context.Context, userID int32, req *book_service.ShelfBooksRequest) (*book_service.ShelfBooksResponse, error)
+}
+
+type GoalRepoI interface {
+	Set(ctx context.Context, userID int32, req *book_service.SetGoalRequest) (*book_service.Goal, error)
+	GetByPKey(ctx context.Context, userID int32, req *book_service.GoalPK) (*book_service.Goal, error)
+	GetAll(ctx context.Context, userID int32, req *book_service.GoalListRequest) (*book_service.GoalListResponse, error)
+	Delete(ctx context.Context, userID int32, req *book_service.GoalPK) (int64, error)
+	GetDone(ctx context.Context, userID int32, goal *book_service.Goal) (int32, error)
+}
+
+type StatsRepoI interface {
+	Get(ctx context.Context, userID int32, req *book_service.StatsRequest) (*book_service.Stats, error)
+}
+
+type NoteRepoI interface {
+	Create(ctx context.Context, userID int32, req *book_service.CreateNoteRequest) (*book_service.Note, error)
+	GetByPKey(ctx context.Context, userID int32, req *book_service.NotePK) (*book_service.Note, error)
+	Update(ctx context.Context, userID int32, req *book_service.UpdateNoteRequest) (*book_service.Note, error)
+	Delete(ctx context.Context, userID int32, req *book_service.NotePK) (int64, error)
+	GetAll(ctx context.Context, userID int32, req *book_service.NoteListRequest) (*book_service.NoteListResponse, error)
+}
+
+type LoanRepoI interface {
+	Lend(ctx context.Context, userID int32, req *book_service.LendBookRequest) (*book_service.Loan, error)
+	Return(ctx context.Context, userID int32, req *book_service.ReturnBookRequest) (*book_service.Loan, error)
+	GetAll(ctx context.Context, userID int32, req *book_service.LoanListRequest) (*book_service.LoanListResponse, error)
+}
+
+type SeriesRepoI interface {
+	Create(ctx context.Context, userID int32, req *book_service.CreateSeriesRequest) (*book_service.Series, error)
+	GetByPKey(ctx context.Context, userID int32, req *book_service.SeriesPK) (*book_service.Series, error)
+	Update(ctx context.Context, userID int32, req *book_service.UpdateSeriesRequest) (*book_service.Series, error)
+	Delete(ctx context.Context, userID int32, req *book_service.SeriesPK) (int64, error)
+	GetAll(ctx context.Context, userID int32, req *book_service.SeriesListRequest) (*book_service.SeriesListResponse, error)
+	SetBook(ctx context.Context, userID int32, req *book_service.SetBookSeriesRequest) error
+	GetNext(ctx context.Context, userID int32, req *book_service.SeriesPK) (*book_service.Book, error)
+}
+
+type WorkRepoI interface {
+	GetByPKey(ctx context.Context, userID int32, req *book_service.WorkPK) (*book_service.Work, error)
+	GetEditions(ctx context.Context, userID int32, req *book_service.EditionListRequest) (*book_service.BookListResponse, error)
+	SetStatus(ctx context.Context, userID int32, req *book_service.SetWorkStatusRequest) error
+}
+
+type LocationRepoI interface {
+	Create(ctx context.Context, userID int32, req *book_service.CreateLocationRequest) (*book_service.Location, error)
+	Update(ctx context.Context, userID int32, req *book_service.UpdateLocationRequest) (*book_service.Location, error)
+	Delete(ctx context.Context, userID int32, req *book_service.LocationPK) (int64, error)
+	GetAll(ctx context.Context, userID int32, req *book_service.LocationListRequest) (*book_service.LocationListResponse, error)
+}
+
+type CopyRepoI interface {
+	Create(ctx context.Context, userID int32, req *book_service.CreateCopyRequest) (*book_service.Copy, error)
+	GetByPKey(ctx context.Context, userID int32, req *book_service.CopyPK) (*book_service.Copy, error)
+	Update(ctx context.Context, userID int32, req *book_service.UpdateCopyRequest) (*book_service.Copy, error)
+	Move(ctx context.Context, userID int32, req *book_service.MoveCopyRequest) (*book_service.Copy, error)
+	Delete(ctx context.Context, userID int32, req *book_service.CopyPK) error
+	GetAll(ctx context.Context, userID int32, req *book_service.CopyListRequest) (*book_service.CopyListResponse, error)
+}
+
+type ReadRepoI interface {
+	Start(ctx context.Context, userID int32, req *book_service.BookPK) (*book_service.Read, error)
+	Abandon(ctx context.Context, userID int32, req *book_service.BookPK) (*book_service.Read, error)
+	GetAll(ctx context.Context, userID int32, req *book_service.ReadListRequest) (*book_service.ReadListResponse, error)
 }